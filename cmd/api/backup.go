@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/backup"
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/game"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+const dumpUsage = `Usage: wordmon dump [--store BACKEND] [--out FICHIER]
+
+Exporte l'état complet du store (mots, joueurs, captures, jetons, webhooks)
+en NDJSON versionné. --out - écrit sur la sortie standard.
+`
+
+const restoreUsage = `Usage: wordmon restore [--store BACKEND] (--in FICHIER | --snapshot FICHIER) [--tokens-out FICHIER]
+
+Restaure un dump de wordmon dump, ou importe un data/snapshot.json, dans un
+store vide. --in - lit l'entrée standard.
+
+La restauration n'est pas transactionnelle : en cas d'échec, la cible reste
+partiellement remplie et doit être vidée avant de relancer (sql, gorm :
+wordmon migrate to 0 puis wordmon migrate up ; file : supprimer server.dataDir).
+
+Un joueur restauré sous un nouvel ID (ID non UUID vers sql ou gorm) perd ses
+jetons : un nouveau jeton, signé avec le secret du serveur (auth.secret ou
+auth.secretFile), est écrit dans --tokens-out pour lui être transmis.
+`
+
+// runDump exécute la sous-commande dump
+func runDump(args []string) error {
+	flags := flag.NewFlagSet("dump", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, dumpUsage) }
+	backend := flags.String("store", "", "Backend source: file, sql ou gorm (défaut: server.store)")
+	out := flags.String("out", "data/dump.ndjson", "Fichier de sortie")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	gameConfig, err := backupConfig(*backend)
+	if err != nil {
+		return err
+	}
+	src, _, err := openStore(gameConfig)
+	if err != nil {
+		return fmt.Errorf("erreur ouverture store: %w", err)
+	}
+	defer src.Close()
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		if err := os.MkdirAll(filepath.Dir(*out), 0755); err != nil {
+			return fmt.Errorf("erreur création répertoire: %w", err)
+		}
+		file, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("erreur création dump: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	counts, err := backup.Dump(context.Background(), src, w, gameConfig.Server.Store)
+	if err != nil {
+		return err
+	}
+	if file, ok := w.(*os.File); ok && file != os.Stdout {
+		if err := file.Sync(); err != nil {
+			return fmt.Errorf("erreur écriture dump: %w", err)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "[dump] %s: %d mots, %d versions de catalogue, %d joueurs, %d captures, %d jetons, %d webhooks\n",
+		*out, counts.Words, counts.CatalogVersions, counts.Players, counts.Captures, counts.Tokens, counts.Webhooks)
+	return nil
+}
+
+// runRestore exécute la sous-commande restore
+func runRestore(args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, restoreUsage) }
+	backend := flags.String("store", "", "Backend cible: file, sql ou gorm (défaut: server.store)")
+	in := flags.String("in", "", "Dump à restaurer")
+	snapshotPath := flags.String("snapshot", "", "Snapshot JSON à importer")
+	tokensOut := flags.String("tokens-out", "data/reissued-tokens.json", "Fichier des jetons réémis")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if (*in == "") == (*snapshotPath == "") {
+		flags.Usage()
+		return fmt.Errorf("--in ou --snapshot requis (un seul)")
+	}
+
+	gameConfig, err := backupConfig(*backend)
+	if err != nil {
+		return err
+	}
+	dst, _, err := openStore(gameConfig)
+	if err != nil {
+		return fmt.Errorf("erreur ouverture store: %w", err)
+	}
+	defer dst.Close()
+
+	// Réémission avec le secret du serveur (auth.secret ou auth.secretFile)
+	secret := []byte(gameConfig.Auth.Secret)
+	if len(secret) == 0 {
+		if secret, _, err = auth.LoadOrCreateSecret(gameConfig.Auth.SecretFile); err != nil {
+			return fmt.Errorf("erreur secret des jetons: %w", err)
+		}
+	}
+	issuer := auth.NewIssuer(secret, dst)
+
+	ctx := context.Background()
+	var report *backup.Report
+	if *snapshotPath != "" {
+		report, err = importSnapshot(ctx, dst, gameConfig, *snapshotPath, issuer)
+	} else {
+		var r io.Reader = os.Stdin
+		if *in != "-" {
+			file, openErr := os.Open(*in)
+			if openErr != nil {
+				return fmt.Errorf("erreur ouverture dump: %w", openErr)
+			}
+			defer file.Close()
+			r = file
+		}
+		report, err = backup.Restore(ctx, dst, r, issuer)
+	}
+	if err != nil && report != nil {
+		fmt.Fprintf(os.Stderr, "[restore] échec : la cible est partiellement remplie, %s avant de relancer\n", wipeHint(gameConfig))
+	}
+	if err != nil {
+		return err
+	}
+
+	printRestoreReport(report)
+	if len(report.Reissued) > 0 {
+		if err := writeReissuedTokens(*tokensOut, report.Reissued); err != nil {
+			return err
+		}
+		fmt.Printf("[restore] %d jeton(s) réémis écrit(s) dans %s : à transmettre aux joueurs\n", len(report.Reissued), *tokensOut)
+	}
+	return nil
+}
+
+// writeReissuedTokens écrit les jetons réémis, lisibles du seul opérateur
+func writeReissuedTokens(path string, tokens []backup.ReissuedToken) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("erreur création répertoire: %w", err)
+	}
+	data, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return fmt.Errorf("erreur encodage jetons: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("erreur écriture jetons réémis: %w", err)
+	}
+	return nil
+}
+
+// wipeHint indique comment vider la cible d'une restauration interrompue
+func wipeHint(gameConfig *config.GameConfig) string {
+	if gameConfig.Server.Store == "file" {
+		return fmt.Sprintf("supprimer %s", gameConfig.Server.DataDir)
+	}
+	return "la vider (wordmon migrate to 0 puis wordmon migrate up)"
+}
+
+// backupConfig charge la config et applique --store ; le store mémoire
+// n'a rien à exporter ni ne conserve une restauration. Les délais par
+// opération sont levés : lire tous les joueurs d'un coup dépasserait celui
+// d'une requête de l'API et tronquerait le dump.
+func backupConfig(backend string) (*config.GameConfig, error) {
+	overrides := config.Overrides{}
+	if backend != "" {
+		overrides["server.store"] = backend
+	}
+	gameConfig, err := config.LoadGameConfigWith(config.DefaultFiles.Game, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement config: %w", err)
+	}
+	if gameConfig.Server.Store == "memory" {
+		return nil, fmt.Errorf("store memory non persistant: choisir file, sql ou gorm avec --store")
+	}
+	gameConfig.Server.ReadTimeoutMs = 0
+	gameConfig.Server.WriteTimeoutMs = 0
+	return gameConfig, nil
+}
+
+// importSnapshot importe un snapshot avec le catalogue de configs/words.json
+func importSnapshot(ctx context.Context, dst store.Store, gameConfig *config.GameConfig, path string, issuer *auth.Issuer) (*backup.Report, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("erreur lecture snapshot: %w", err)
+	}
+	snapshot, err := config.LoadSnapshot(path)
+	if err != nil {
+		return nil, err
+	}
+	wordsConfig, err := config.LoadWordsConfig("configs/words.json")
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement words: %w", err)
+	}
+	return backup.ImportSnapshot(ctx, dst, snapshot, game.WordsFromConfig(wordsConfig, gameConfig), issuer)
+}
+
+// printRestoreReport affiche le bilan d'une restauration
+func printRestoreReport(report *backup.Report) {
+	c := report.Counts
+	fmt.Printf("[restore] %s v%d (%s): %d mots, %d versions de catalogue, %d joueurs, %d captures, %d jetons, %d webhooks\n",
+		report.Header.Format, report.Header.Version, report.Header.Source,
+		c.Words, c.CatalogVersions, c.Players, c.Captures, c.Tokens, c.Webhooks)
+	if report.Remapped > 0 {
+		fmt.Printf("[restore] %d joueur(s) restauré(s) sous un nouvel ID, %d jeton(s) non repris\n", report.Remapped, report.SkippedTokens)
+	}
+	if report.SkippedWebhooks > 0 {
+		fmt.Printf("[restore] %d webhook(s) ignoré(s)\n", report.SkippedWebhooks)
+	}
+	if report.Unknown > 0 {
+		fmt.Printf("[restore] %d enregistrement(s) de type inconnu ignoré(s)\n", report.Unknown)
+	}
+	if report.UnknownWords > 0 {
+		fmt.Printf("[restore] %d entrée(s) d'inventaire sans mot au catalogue ignorée(s)\n", report.UnknownWords)
+	}
+	for _, m := range report.XPMismatches {
+		fmt.Printf("[restore] attention: %s (%s) a %d XP pour %d points de captures\n", m.Name, m.PlayerID, m.XP, m.CapturePoints)
+	}
+}
@@ -2,22 +2,30 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"flag"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/SamG1008/wordmon-go/internal/api"
+	"github.com/SamG1008/wordmon-go/internal/backup"
 	"github.com/SamG1008/wordmon-go/internal/config"
 	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/game"
+	"github.com/SamG1008/wordmon-go/internal/grpcapi"
 	"github.com/SamG1008/wordmon-go/internal/store"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
 	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
 )
 
-const Version = "0.7.0"
+const Version = "0.9.0"
 
 func init() {
 	// Charger les variables d'environnement depuis le fichier .env
@@ -26,6 +34,14 @@ func init() {
 	}
 }
 
+// subcommands sous-commandes de l'exécutable (wordmon <commande> ...)
+var subcommands = map[string]func(args []string) error{
+	"migrate": runMigrate,
+	"dump":    runDump,
+	"restore": runRestore,
+	"words":   runWords,
+}
+
 func main() {
 	// Gestion des panics
 	defer func() {
@@ -39,11 +55,27 @@ func main() {
 	// Initialiser le générateur aléatoire
 	rand.New(rand.NewSource(time.Now().UnixNano()))
 
-	// Flags CLI
+	// Sous-commandes
+	if len(os.Args) > 1 {
+		if run, exists := subcommands[os.Args[1]]; exists {
+			if err := run(os.Args[2:]); err != nil {
+				fmt.Printf("Erreur %s: %v\n", os.Args[1], err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	// Flags CLI (prioritaires sur la config)
 	showVersion := flag.Bool("version", false, "Affiche la version")
 	showVersionShort := flag.Bool("v", false, "Affiche la version")
-	port := flag.String("port", "8080", "Port du serveur")
-	portShort := flag.String("p", "8080", "Port du serveur")
+	port := flag.String("port", "", "Port du serveur (défaut: server.port)")
+	portShort := flag.String("p", "", "Port du serveur (défaut: server.port)")
+	storeBackend := flag.String("store", "", "Backend de stockage: memory, file, sql ou gorm (défaut: server.store)")
+	grpcPort := flag.String("grpc-port", "", "Port du service gRPC, off pour le désactiver (défaut: server.grpcPort)")
+	overrides := config.Overrides{}
+	flag.Var(overrides, "set", "Réglage section.clé=valeur, prioritaire sur fichier et env (répétable)")
+	printConfig := flag.Bool("print-config", false, "Affiche la config effective et l'origine de chaque valeur")
 
 	flag.Parse()
 
@@ -53,118 +85,246 @@ func main() {
 		os.Exit(0)
 	}
 
-	// Déterminer le port
-	serverPort := *port
-	if serverPort == "8080" && *portShort != "8080" {
-		serverPort = *portShort
-	}
-
 	fmt.Printf("WordMon Go API Server version %s\n", Version)
-	fmt.Printf("Exercice 07: API REST avec Gin\n")
 	fmt.Println("=== Chargement des configurations ===")
 
+	// Flags dédiés, raccourcis de --set
+	if *port == "" {
+		port = portShort
+	}
+	for key, value := range map[string]string{
+		"server.port":     *port,
+		"server.store":    *storeBackend,
+		"server.grpcPort": *grpcPort,
+	} {
+		if value != "" {
+			overrides[key] = value
+		}
+	}
+
 	// Charger les configurations
-	gameConfig, err := config.LoadGameConfig("configs/game.yaml")
+	gameConfig, err := config.LoadGameConfigWith(config.DefaultFiles.Game, overrides)
 	if err != nil {
 		fmt.Printf("Erreur chargement config: %v\n", err)
 		os.Exit(1)
 	}
+	if *printConfig {
+		fmt.Println()
+		config.PrintSettings(os.Stdout, gameConfig)
+		return
+	}
 
-	wordsConfig, err := config.LoadWordsConfig("configs/words.json")
+	wordsConfig, err := config.LoadWordsConfig(config.DefaultFiles.Words)
 	if err != nil {
 		fmt.Printf("Erreur chargement words: %v\n", err)
 		os.Exit(1)
 	}
 
-	challengesConfig, err := config.LoadChallengesConfig("configs/challenges.yaml")
+	challengesConfig, err := config.LoadChallengesConfig(config.DefaultFiles.Challenges)
 	if err != nil {
 		fmt.Printf("Erreur chargement challenges: %v\n", err)
 		os.Exit(1)
 	}
-	_ = challengesConfig // Utilisé dans de futurs exercices
 
-	// Configurer le système de mots
-	configureGameSystems(wordsConfig, gameConfig)
+	// Ouvrir le store
+	gameStore, db, err := openStore(gameConfig)
+	if err != nil {
+		fmt.Printf("Erreur ouverture store %s: %v\n", gameConfig.Server.Store, err)
+		os.Exit(1)
+	}
+	defer gameStore.Close()
 
-	fmt.Printf("[config] API configurée avec %d mots\n", len(wordsConfig.Words))
+	// Store mémoire : reprendre les joueurs du dernier snapshot
+	words := game.WordsFromConfig(wordsConfig, gameConfig)
+	var snapshotter *backup.Snapshotter
+	if gameConfig.Server.Store == "memory" {
+		snapshotter = backup.NewSnapshotter(gameStore, gameConfig.Server.SnapshotPath, gameConfig.Server.SnapshotKeep)
+		report, err := snapshotter.Load(context.Background(), words)
+		if err != nil {
+			fmt.Printf("Erreur chargement snapshot: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("[snapshot] %d joueurs et %d captures restaurés\n", report.Counts.Players, report.Counts.Captures)
+	}
+
+	// Catalogue des mots
+	if err := gameStore.Seed(context.Background(), words); err != nil {
+		fmt.Printf("Erreur seed words: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Catalogue en base modifié depuis : signaler sans appliquer
+	if catalog, ok := gameStore.(store.CatalogStore); ok {
+		if plan, err := catalog.SyncWords(context.Background(), words, false); err != nil {
+			fmt.Printf("Avertissement: vérification du catalogue: %v\n", err)
+		} else if !plan.Empty() {
+			fmt.Printf("[words] configs/words.json diffère du catalogue (version %d): wordmon words sync --apply\n", plan.Version)
+		}
+	}
+
+	fmt.Printf("[config] API configurée avec %d mots (store: %s)\n", len(wordsConfig.Words), gameConfig.Server.Store)
 	fmt.Println()
 
-	// Créer le store en mémoire
-	memStore := store.NewMemoryStore()
+	// Bus d'événements : journal et succès des joueurs
+	bus := core.NewEventBus()
+	bus.Subscribe(core.NewEventLogger(os.Stdout))
+	achievements := core.NewAchievements(os.Stdout)
+	bus.SubscribeAsync(64, achievements.Handle, core.EventWordCaptured, core.EventLevelUp)
 
 	// Créer le serveur API
-	server := api.NewServer(memStore, gameConfig)
-
-	// Créer et démarrer le spawner
-	spawnInterval := time.Duration(gameConfig.Spawner.IntervalSeconds) * time.Second
-	fleeTimeout := time.Duration(gameConfig.Spawner.AutoFleeAfterSeconds) * time.Second
-	spawner := api.NewSpawnerService(memStore, spawnInterval, fleeTimeout)
+	server, err := api.NewServer(gameStore, gameConfig, bus)
+	if err != nil {
+		fmt.Printf("Erreur création serveur: %v\n", err)
+		os.Exit(1)
+	}
+	server.Game().Configure(game.RulesFromConfig(gameConfig, challengesConfig))
 
 	// Context pour arrêt propre
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	// Démarrer le spawner en arrière-plan
-	go spawner.Start(ctx)
+	server.StartSpawner(ctx)
+
+	// Rechargement à chaud des configs (fichiers modifiés ou SIGHUP)
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+	watcher := config.NewWatcher(config.DefaultFiles, overrides,
+		&config.Bundle{Game: gameConfig, Words: wordsConfig, Challenges: challengesConfig},
+		func(next *config.Bundle) error {
+			sync, err := server.Game().Reload(ctx, next.Game, next.Words, next.Challenges)
+			if err != nil {
+				return err
+			}
+			switch {
+			case sync == nil:
+			case sync.Applied:
+				fmt.Printf("[words] catalogue version %d enregistré (%d ajout(s), %d modification(s), %d retrait(s))\n",
+					sync.Version, len(sync.Added), len(sync.Updated), len(sync.Retired))
+			case !sync.Empty():
+				fmt.Printf("[words] configs/words.json diffère du catalogue (version %d): %d ajout(s), %d modification(s), %d retrait(s), %d restauration(s) en attente ; wordmon words sync --apply ou server.reloadSyncWords\n",
+					sync.Version, len(sync.Added), len(sync.Updated), len(sync.Retired), len(sync.Restored))
+			}
+			return nil
+		})
+	watcher.Start(ctx, time.Duration(gameConfig.Server.ReloadSeconds)*time.Second, hupChan)
+
+	// Compaction périodique du journal du store fichier
+	if fileStore, ok := gameStore.(*store.FileStore); ok && gameConfig.Server.CompactIntervalSeconds > 0 {
+		fileStore.StartCompaction(ctx, time.Duration(gameConfig.Server.CompactIntervalSeconds)*time.Second)
+	}
+
+	// Sauvegardes périodiques du store mémoire
+	if snapshotter != nil && gameConfig.Server.SnapshotSeconds > 0 {
+		snapshotter.Start(ctx, time.Duration(gameConfig.Server.SnapshotSeconds)*time.Second)
+	}
+
+	// Limitation des tentatives de capture (mémoire ou partagée via Postgres)
+	limiter := api.NewRateLimiter(gameConfig, db)
+	limiter.Start(ctx, time.Minute)
+	server.SetRateLimiter(limiter)
+
+	// Webhooks : livraisons signées des événements notables
+	if webhooks, ok := gameStore.(webhook.Store); ok {
+		dispatcher := webhook.NewDispatcher(webhooks, api.WebhookOptionsFromConfig(gameConfig))
+		dispatcher.Start(ctx)
+		bus.SubscribeAsync(256, dispatcher.Handle, core.EventPlayerCreated, core.EventWordCaptured, core.EventLevelUp)
+	}
+
+	// Service gRPC du maître du jeu (même état que l'API REST)
+	var grpcServer *grpc.Server
+	if gameConfig.Server.GRPCPort != "off" {
+		grpcServer = grpcapi.NewGRPCServer(server.Game(), gameConfig.Admin.Token)
+		go func() {
+			fmt.Printf("[grpc] Démarrage du service gRPC sur :%s\n", gameConfig.Server.GRPCPort)
+			if err := grpcapi.Serve(ctx, grpcServer, gameConfig.Server.GRPCPort); err != nil {
+				fmt.Printf("Erreur serveur gRPC: %v\n", err)
+				cancel()
+			}
+		}()
+	}
 
 	// Gérer l'arrêt propre avec Ctrl+C
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
 	// Démarrer le serveur dans une goroutine
+	httpServer := server.HTTPServer(gameConfig.Server.Port)
 	go func() {
-		fmt.Printf("[server] Démarrage du serveur sur :%s\n", serverPort)
-		fmt.Printf("[server] Endpoints disponibles:\n")
-		fmt.Printf("  GET  /status\n")
-		fmt.Printf("  POST /players\n")
-		fmt.Printf("  GET  /players/:id\n")
-		fmt.Printf("  GET  /spawn/current\n")
-		fmt.Printf("  POST /encounter/attempt\n")
-		fmt.Printf("  GET  /leaderboard\n")
+		fmt.Printf("[server] Démarrage du serveur sur :%s\n", gameConfig.Server.Port)
+		fmt.Printf("[server] Documentation: http://localhost:%s/docs (spécification: /openapi.json)\n", gameConfig.Server.Port)
 		fmt.Println()
 
-		if err := server.Run(serverPort); err != nil {
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			fmt.Printf("Erreur serveur: %v\n", err)
 			cancel()
 		}
 	}()
 
-	// Attendre le signal d'arrêt
-	<-sigChan
-	fmt.Println("\n[server] Arrêt demandé par l'utilisateur...")
+	// Attendre le signal d'arrêt (ou l'échec du serveur)
+	select {
+	case <-sigChan:
+		fmt.Println("\n[server] Arrêt demandé par l'utilisateur...")
+	case <-ctx.Done():
+	}
+
+	// Plus de nouvelles requêtes ; celles en cours se terminent avant l'arrêt
+	// des tâches de fond et la sauvegarde finale
+	shutdownCtx, stop := context.WithTimeout(context.Background(), 10*time.Second)
+	defer stop()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		fmt.Printf("Erreur arrêt serveur: %v\n", err)
+	}
+	if grpcServer != nil {
+		grpcapi.Shutdown(shutdownCtx, grpcServer)
+	}
 	cancel()
 
-	// Laisser le temps aux goroutines de se terminer
-	time.Sleep(500 * time.Millisecond)
+	// Sauvegarde finale du store mémoire
+	if snapshotter != nil {
+		if err := snapshotter.Save(context.Background()); err != nil {
+			fmt.Printf("Erreur sauvegarde snapshot: %v\n", err)
+		}
+	}
 
 	fmt.Println("[server] Serveur arrêté proprement")
 }
 
-// configureGameSystems configure le système de mots avec les données chargées
-func configureGameSystems(wordsConfig *config.WordsConfig, gameConfig *config.GameConfig) {
-	// Convertir les WordEntry du config vers les types du core
-	words := make([]core.WordEntry, len(wordsConfig.Words))
-	for i, entry := range wordsConfig.Words {
-		words[i] = core.WordEntry{
-			ID:     entry.ID,
-			Text:   entry.Text,
-			Rarity: entry.Rarity,
-		}
+// openStore ouvre le backend de stockage de la config ; la connexion SQL
+// retournée (nil pour les stores mémoire et fichier) sert au limiteur partagé
+func openStore(gameConfig *config.GameConfig) (store.Store, *sql.DB, error) {
+	timeouts := store.Timeouts{
+		Read:  time.Duration(gameConfig.Server.ReadTimeoutMs) * time.Millisecond,
+		Write: time.Duration(gameConfig.Server.WriteTimeoutMs) * time.Millisecond,
 	}
 
-	// Configurer les poids et récompenses
-	weights := core.RarityWeights{
-		Common:    gameConfig.RarityWeights.Common,
-		Rare:      gameConfig.RarityWeights.Rare,
-		Legendary: gameConfig.RarityWeights.Legendary,
-	}
-
-	rewards := core.XPRewards{
-		Common:    gameConfig.XPRewards.Common,
-		Rare:      gameConfig.XPRewards.Rare,
-		Legendary: gameConfig.XPRewards.Legendary,
+	switch gameConfig.Server.Store {
+	case "file":
+		fileStore, err := store.NewFileStore(gameConfig.Server.DataDir)
+		if err != nil {
+			return nil, nil, err
+		}
+		return fileStore, nil, nil
+	case "sql":
+		sqlStore, err := store.NewSQLStore(gameConfig.Server.DatabaseURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		sqlStore.SetTimeouts(timeouts)
+		return sqlStore, sqlStore.DB(), nil
+	case "gorm":
+		gormStore, err := store.NewGORMStore(gameConfig.Server.DatabaseURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		db, err := gormStore.DB()
+		if err != nil {
+			gormStore.Close()
+			return nil, nil, err
+		}
+		gormStore.SetTimeouts(timeouts)
+		return gormStore, db, nil
+	default:
+		return store.NewMemoryStore(), nil, nil
 	}
-
-	// Appliquer la configuration au système de mots
-	core.ConfigureWords(words, weights, rewards)
 }
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/migrate"
+)
+
+const migrateUsage = `Usage: wordmon migrate [--database-url URL] <commande>
+
+Commandes:
+  up        applique toutes les migrations en attente
+  down [N]  annule les N dernières migrations (1 par défaut)
+  status    affiche l'état de chaque migration
+  to N      amène le schéma à la version N (0 : schéma vide)
+
+Une base créée avant schema_migrations (AutoMigrate ou scripts SQL) est
+reprise au premier up : les migrations déjà présentes sont enregistrées
+sans être rejouées.
+`
+
+// runMigrate exécute la sous-commande migrate sur la base de la config
+// (server.databaseURL ou DATABASE_URL)
+func runMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, migrateUsage) }
+	databaseURL := flags.String("database-url", "", "URL Postgres (défaut: server.databaseURL)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("commande migrate manquante")
+	}
+
+	if *databaseURL == "" {
+		gameConfig, err := config.LoadGameConfig("configs/game.yaml")
+		if err != nil {
+			return fmt.Errorf("erreur chargement config: %w", err)
+		}
+		*databaseURL = gameConfig.Server.DatabaseURL
+	}
+
+	db, err := sql.Open("postgres", *databaseURL)
+	if err != nil {
+		return fmt.Errorf("erreur connexion DB: %w", err)
+	}
+	defer db.Close()
+
+	migrator, err := migrate.Default(db)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	command, rest := flags.Arg(0), flags.Args()[1:]
+	var count int
+	switch command {
+	case "up":
+		count, err = migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(rest) > 0 {
+			if steps, err = strconv.Atoi(rest[0]); err != nil {
+				return fmt.Errorf("nombre de migrations invalide: %s", rest[0])
+			}
+		}
+		count, err = migrator.Down(ctx, steps)
+	case "to":
+		if len(rest) == 0 {
+			return fmt.Errorf("version cible manquante")
+		}
+		target, convErr := strconv.Atoi(rest[0])
+		if convErr != nil {
+			return fmt.Errorf("version invalide: %s", rest[0])
+		}
+		count, err = migrator.To(ctx, target)
+	case "status":
+		return printMigrationStatus(ctx, migrator)
+	default:
+		flags.Usage()
+		return fmt.Errorf("commande migrate inconnue: %s", command)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("[migrate] %d migration(s) exécutée(s)\n", count)
+	return nil
+}
+
+// printMigrationStatus affiche le tableau des migrations
+func printMigrationStatus(ctx context.Context, migrator *migrate.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNOM\tÉTAT\tAPPLIQUÉE LE")
+	for _, s := range statuses {
+		state, appliedAt := "en attente", "-"
+		if s.Applied {
+			state, appliedAt = "appliquée", s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		switch {
+		case s.Name == "":
+			state = "appliquée, fichier absent"
+		case s.Modified:
+			state = "appliquée, MODIFIÉE"
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/game"
+	"github.com/SamG1008/wordmon-go/internal/lexicon"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+const wordsUsage = `Usage:
+  wordmon words sync [--store BACKEND] [--apply]
+  wordmon words import [--format txt|csv|json] [--out FICHIER] [--merge] [--dry-run] LISTE
+  wordmon words import --sync [--store BACKEND] [--apply] LISTE
+
+sync compare configs/words.json au catalogue du store (backends file, sql et
+gorm) et affiche les mots à ajouter, modifier, retirer ou restaurer. Avec --apply,
+les changements sont ensuite appliqués et une nouvelle version du catalogue
+est enregistrée. Un mot retiré reste lisible pour les captures passées.
+
+import filtre une liste de mots selon les règles lexicon de la config,
+classe leur rareté puis écrit words.json, ou synchronise directement le
+store avec --sync. Les mots déjà présents dans --out gardent leur ID.
+`
+
+// runWords exécute la sous-commande words
+func runWords(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, wordsUsage)
+		return fmt.Errorf("commande words manquante")
+	}
+	switch args[0] {
+	case "sync":
+		return runWordsSync(args[1:])
+	case "import":
+		return runWordsImport(args[1:])
+	default:
+		fmt.Fprint(os.Stderr, wordsUsage)
+		return fmt.Errorf("commande words inconnue: %s", args[0])
+	}
+}
+
+// runWordsSync aligne le catalogue en base sur configs/words.json
+func runWordsSync(args []string) error {
+	flags := flag.NewFlagSet("words sync", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, wordsUsage) }
+	backend := flags.String("store", "", "Backend: file, sql ou gorm (défaut: server.store)")
+	apply := flags.Bool("apply", false, "Applique les changements après le rapport")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	gameConfig, err := wordsConfigFor(*backend)
+	if err != nil {
+		return err
+	}
+	wordsConfig, err := config.LoadWordsConfig("configs/words.json")
+	if err != nil {
+		return fmt.Errorf("erreur chargement words: %w", err)
+	}
+	return syncCatalog(gameConfig, game.WordsFromConfig(wordsConfig, gameConfig), *apply)
+}
+
+// runWordsImport importe une liste de mots
+func runWordsImport(args []string) error {
+	flags := flag.NewFlagSet("words import", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, wordsUsage) }
+	format := flags.String("format", "", "Format de la liste: txt, csv ou json (défaut: extension)")
+	out := flags.String("out", "configs/words.json", "Dictionnaire à écrire")
+	merge := flags.Bool("merge", false, "Conserve les mots de --out absents de la liste")
+	dryRun := flags.Bool("dry-run", false, "Affiche le rapport sans écrire --out")
+	toStore := flags.Bool("sync", false, "Synchronise le store au lieu d'écrire --out")
+	backend := flags.String("store", "", "Backend pour --sync: file, sql ou gorm (défaut: server.store)")
+	apply := flags.Bool("apply", false, "Avec --sync, applique les changements après le rapport")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("une liste de mots attendue")
+	}
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = lexicon.FormatFromPath(path)
+	}
+
+	gameConfig, err := wordsConfigFor(*backend)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("erreur ouverture liste: %w", err)
+	}
+	entries, err := lexicon.Read(file, *format)
+	file.Close()
+	if err != nil {
+		return err
+	}
+
+	kept, rejected := lexicon.Filter(entries, gameConfig.Lexicon)
+	fmt.Printf("[words] %s: %d mots lus, %d retenus, %d écartés\n", path, len(entries), len(kept), len(rejected))
+	for i, rejection := range rejected {
+		if i == 20 {
+			fmt.Printf("  ... et %d autres\n", len(rejected)-i)
+			break
+		}
+		fmt.Printf("  x %q: %s\n", rejection.Text, rejection.Reason)
+	}
+
+	existing, err := readWordEntries(*out)
+	if err != nil {
+		return err
+	}
+	scored, err := lexicon.Classify(kept, gameConfig.Lexicon)
+	if err != nil {
+		return err
+	}
+	entriesOut := lexicon.AssignIDs(scored, existing)
+	if *merge {
+		entriesOut = mergeWordEntries(entriesOut, existing)
+	}
+	printRarityReport(scored)
+
+	if *toStore {
+		return syncCatalog(gameConfig, game.WordsFromConfig(&config.WordsConfig{Words: entriesOut}, gameConfig), *apply)
+	}
+	if *dryRun {
+		fmt.Printf("[words] simulation : %s non modifié\n", *out)
+		return nil
+	}
+	return config.SaveWordsConfig(entriesOut, *out)
+}
+
+// wordsConfigFor charge la config et applique --store
+func wordsConfigFor(backend string) (*config.GameConfig, error) {
+	overrides := config.Overrides{}
+	if backend != "" {
+		overrides["server.store"] = backend
+	}
+	gameConfig, err := config.LoadGameConfigWith(config.DefaultFiles.Game, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement config: %w", err)
+	}
+	return gameConfig, nil
+}
+
+// syncCatalog affiche l'écart entre words et le catalogue du store, puis
+// l'applique si apply
+func syncCatalog(gameConfig *config.GameConfig, words []core.Word, apply bool) error {
+	if gameConfig.Server.Store == "memory" {
+		return fmt.Errorf("store memory: le serveur applique le catalogue à chaque démarrage et rechargement")
+	}
+	gameStore, _, err := openStore(gameConfig)
+	if err != nil {
+		return fmt.Errorf("erreur ouverture store: %w", err)
+	}
+	defer gameStore.Close()
+
+	catalog, ok := gameStore.(store.CatalogStore)
+	if !ok {
+		return fmt.Errorf("store %s: catalogue non synchronisable", gameConfig.Server.Store)
+	}
+
+	ctx := context.Background()
+	plan, err := catalog.SyncWords(ctx, words, false)
+	if err != nil {
+		return err
+	}
+	printCatalogDiff(plan)
+	if !apply {
+		if !plan.Empty() {
+			fmt.Println("[words] simulation : relancer avec --apply pour appliquer")
+		}
+		return nil
+	}
+
+	result, err := catalog.SyncWords(ctx, words, true)
+	if err != nil {
+		return err
+	}
+	if result.Applied {
+		fmt.Printf("[words] catalogue version %d enregistré (%s)\n", result.Version, result.Checksum[:12])
+	}
+	return nil
+}
+
+// readWordEntries lit un words.json sans le valider (absent : aucun mot)
+func readWordEntries(path string) ([]config.WordEntry, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture %s: %w", path, err)
+	}
+	var entries []config.WordEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("erreur parsing JSON %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// mergeWordEntries ajoute à imported les mots de existing qu'elle ne contient pas
+func mergeWordEntries(imported, existing []config.WordEntry) []config.WordEntry {
+	texts := make(map[string]bool, len(imported))
+	for _, entry := range imported {
+		texts[entry.Text] = true
+	}
+	for _, entry := range existing {
+		if !texts[entry.Text] {
+			imported = append(imported, entry)
+		}
+	}
+	return imported
+}
+
+// printRarityReport affiche la répartition des raretés et les mots les plus rares
+func printRarityReport(scored []lexicon.Scored) {
+	counts := map[string]int{}
+	for _, word := range scored {
+		counts[word.Rarity]++
+	}
+	fmt.Printf("[words] raretés: Common=%d Rare=%d Legendary=%d\n", counts["Common"], counts["Rare"], counts["Legendary"])
+	for i, word := range scored {
+		if i == 5 {
+			break
+		}
+		fmt.Printf("  * %s (%.2f)\n", word.Text, word.Score)
+	}
+}
+
+// printCatalogDiff affiche l'écart entre la config et la base
+func printCatalogDiff(sync *store.CatalogSync) {
+	fmt.Printf("[words] catalogue en base: version %d\n", sync.Version)
+	for _, word := range sync.Added {
+		fmt.Printf("  + %s %q (%s, %d pts)\n", word.ID, word.Text, word.Rarity, word.Points)
+	}
+	for _, change := range sync.Updated {
+		before, after := change.Before, change.After
+		fmt.Printf("  ~ %s %q (%s, %d pts) -> %q (%s, %d pts)\n",
+			after.ID, before.Text, before.Rarity, before.Points, after.Text, after.Rarity, after.Points)
+	}
+	for _, word := range sync.Restored {
+		fmt.Printf("  ^ %s %q restauré\n", word.ID, word.Text)
+	}
+	for _, word := range sync.Retired {
+		fmt.Printf("  - %s %q retiré\n", word.ID, word.Text)
+	}
+	fmt.Printf("[words] %d ajout(s), %d modification(s), %d retrait(s), %d restauration(s), %d inchangé(s)\n",
+		len(sync.Added), len(sync.Updated), len(sync.Retired), len(sync.Restored), sync.Unchanged)
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"math/rand"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/SamG1008/wordmon-go/internal/config"
 	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/game"
+	"github.com/SamG1008/wordmon-go/internal/store"
 	"github.com/joho/godotenv"
 )
 
@@ -61,6 +64,10 @@ func main() {
 
 	duration := flag.Int("duration", 30, "Durée de la démo en secondes (mode concurrent)")
 
+	overrides := config.Overrides{}
+	flag.Var(overrides, "set", "Réglage section.clé=valeur, prioritaire sur fichier et env (répétable)")
+	printConfig := flag.Bool("print-config", false, "Affiche la config effective et l'origine de chaque valeur")
+
 	// Parser les arguments de la ligne de commande
 	flag.Parse()
 
@@ -75,21 +82,26 @@ func main() {
 	fmt.Println("=== Chargement des configurations ===")
 
 	// Charger la configuration principale
-	gameConfig, err = config.LoadGameConfig("configs/game.yaml")
+	gameConfig, err = config.LoadGameConfigWith(config.DefaultFiles.Game, overrides)
 	if err != nil {
 		fmt.Printf("Erreur chargement config: %v\n", err)
 		os.Exit(1)
 	}
+	if *printConfig {
+		fmt.Println()
+		config.PrintSettings(os.Stdout, gameConfig)
+		return
+	}
 
 	// Charger les mots
-	wordsConfig, err = config.LoadWordsConfig("configs/words.json")
+	wordsConfig, err = config.LoadWordsConfig(config.DefaultFiles.Words)
 	if err != nil {
 		fmt.Printf("Erreur chargement words: %v\n", err)
 		os.Exit(1)
 	}
 
 	// Charger les défis
-	challengesConfig, err = config.LoadChallengesConfig("configs/challenges.yaml")
+	challengesConfig, err = config.LoadChallengesConfig(config.DefaultFiles.Challenges)
 	if err != nil {
 		fmt.Printf("Erreur chargement challenges: %v\n", err)
 		os.Exit(1)
@@ -98,6 +110,17 @@ func main() {
 	// Exercice 06: Configurer le système de mots avec les données chargées
 	configureGameSystems()
 
+	// Recharger les mots et leurs réglages sur SIGHUP ou fichier modifié
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+	watcher := config.NewWatcher(config.DefaultFiles, overrides,
+		&config.Bundle{Game: gameConfig, Words: wordsConfig, Challenges: challengesConfig},
+		func(next *config.Bundle) error {
+			configureWords(next.Game, next.Words)
+			return nil
+		})
+	watcher.Start(context.Background(), time.Duration(gameConfig.Server.ReloadSeconds)*time.Second, hupChan)
+
 	fmt.Println()
 
 	// Afficher le message d'accueil avec la version de la config
@@ -115,32 +138,65 @@ func main() {
 
 	fmt.Printf("Bienvenue, Dresseur %s !\n\n", player)
 
-	// Créer un Player avec le constructeur (Exercice 02)
-	gamePlayer := core.NewPlayer("player_001", player)
+	// Le dresseur joue via le service de jeu, comme les clients de l'API
+	session, err := newSession(player)
+	if err != nil {
+		fmt.Printf("Erreur création du jeu: %v\n", err)
+		os.Exit(1)
+	}
 
 	// Routage vers les différents modes
 	if *concurrentMode || *concurrentModeShort {
 		// Exercice 05: Mode concurrent avec spawner
-		runConcurrentMode(&gamePlayer, time.Duration(*duration)*time.Second)
+		runConcurrentMode(time.Duration(*duration) * time.Second)
 	} else if *testMode {
 		// Mode test pour tous les exercices
-		runTestMode(&gamePlayer)
+		runTestMode(session)
 	} else if *interactiveMode || *interactiveModeShort {
 		// Exercices 01-04: Mode interactif classique
-		runInteractiveMode(&gamePlayer)
+		runInteractiveMode(session)
 	} else {
 		// Mode par défaut: montrer les options
-		showMainMenu(&gamePlayer)
+		showMainMenu(session)
 	}
 
 	// Exercice 06: Sauvegarder le snapshot avant de quitter
-	savePlayerSnapshot(&gamePlayer)
+	savePlayerSnapshot(session.player())
 
 	os.Exit(0)
 }
 
+// session dresseur de la CLI et service de jeu local sur lequel il joue
+type session struct {
+	service  *game.GameService
+	playerID string
+}
+
+// newSession inscrit le dresseur name dans un nouveau jeu local
+func newSession(name string) (*session, error) {
+	service, err := newLocalGame()
+	if err != nil {
+		return nil, err
+	}
+	player, err := service.CreatePlayer(context.Background(), name)
+	if err != nil {
+		return nil, err
+	}
+	return &session{service: service, playerID: player.ID}, nil
+}
+
+// player retourne l'état du dresseur dans le store du jeu
+func (s *session) player() core.Player {
+	player, err := s.service.Player(context.Background(), s.playerID)
+	if err != nil {
+		fmt.Printf("Erreur lecture joueur: %v\n", err)
+		return core.NewPlayer(s.playerID, "")
+	}
+	return *player
+}
+
 // savePlayerSnapshot sauvegarde l'état du joueur
-func savePlayerSnapshot(player *core.Player) {
+func savePlayerSnapshot(player core.Player) {
 	players := []config.PlayerSnapshot{
 		{
 			ID:        player.ID,
@@ -158,6 +214,11 @@ func savePlayerSnapshot(player *core.Player) {
 
 // configureGameSystems configure les systèmes du jeu avec les données des fichiers de config
 func configureGameSystems() {
+	configureWords(gameConfig, wordsConfig)
+}
+
+// configureWords applique les mots et leurs réglages au système de mots
+func configureWords(gameConfig *config.GameConfig, wordsConfig *config.WordsConfig) {
 	// Convertir les données de config en format utilisable par core
 	var words []core.WordEntry
 	for _, word := range wordsConfig.Words {
@@ -188,7 +249,7 @@ func configureGameSystems() {
 }
 
 // showMainMenu affiche le menu principal et laisse l'utilisateur choisir
-func showMainMenu(player *core.Player) {
+func showMainMenu(session *session) {
 	scanner := bufio.NewScanner(os.Stdin)
 
 	for {
@@ -208,7 +269,7 @@ func showMainMenu(player *core.Player) {
 
 		switch choice {
 		case "1":
-			runInteractiveMode(player)
+			runInteractiveMode(session)
 		case "2":
 			fmt.Print("Durée de la démo (secondes, défaut 30): ")
 			scanner.Scan()
@@ -217,11 +278,11 @@ func showMainMenu(player *core.Player) {
 			if durationStr != "" {
 				fmt.Sscanf(durationStr, "%d", &duration)
 			}
-			runConcurrentMode(player, time.Duration(duration)*time.Second)
+			runConcurrentMode(time.Duration(duration) * time.Second)
 		case "3":
-			runTestMode(player)
+			runTestMode(session)
 		case "4":
-			displayPlayerStatus(*player)
+			displayPlayerStatus(session.player())
 		case "5":
 			fmt.Println("À bientôt, Dresseur !")
 			return
@@ -235,8 +296,7 @@ func showMainMenu(player *core.Player) {
 }
 
 // runInteractiveMode lance le mode interactif (Exercices 01-04)
-func runInteractiveMode(player *core.Player) {
-	encounter := core.NewEncounter()
+func runInteractiveMode(session *session) {
 	scanner := bufio.NewScanner(os.Stdin)
 
 	fmt.Println("=== Mode Interactif (Exercices 01-04) ===")
@@ -252,9 +312,9 @@ func runInteractiveMode(player *core.Player) {
 
 		switch command {
 		case "rencontre", "r":
-			handleEncounter(encounter, player, scanner)
+			handleEncounter(session, scanner)
 		case "statut", "s":
-			displayPlayerStatus(*player)
+			displayPlayerStatus(session.player())
 		case "quit", "q":
 			fmt.Println("Retour au menu principal...")
 			return
@@ -270,8 +330,9 @@ func runInteractiveMode(player *core.Player) {
 	}
 }
 
-// runConcurrentMode lance le mode concurrent (Exercice 05)
-func runConcurrentMode(humanPlayer *core.Player, duration time.Duration) {
+// runConcurrentMode lance le mode concurrent (Exercice 05) : les joueurs IA
+// jouent via le service de jeu, comme les clients de l'API
+func runConcurrentMode(duration time.Duration) {
 	// Utiliser la configuration pour l'intervalle de spawn
 	spawnInterval := time.Duration(gameConfig.Spawner.IntervalSeconds) * time.Second
 	fleeTimeout := time.Duration(gameConfig.Spawner.AutoFleeAfterSeconds) * time.Second
@@ -283,38 +344,41 @@ func runConcurrentMode(humanPlayer *core.Player, duration time.Duration) {
 	fmt.Println("Appuyez sur Ctrl+C pour arrêter prématurément")
 	fmt.Println()
 
+	service, err := newLocalGame()
+	if err != nil {
+		fmt.Printf("Erreur création du jeu: %v\n", err)
+		return
+	}
+
 	// Créer le contexte avec timeout pour l'arrêt propre
 	ctx, cancel := context.WithTimeout(context.Background(), duration)
 	defer cancel()
 
-	// Channels centraux
-	spawnCh := make(chan core.Word, 10)
-	battleCh := make(chan PlayerAttempt, 100)
-
 	// Créer 3 joueurs IA
 	aiPlayers := []AIPlayer{
-		{ID: "alice", Name: "Alice", SkillLevel: 0.7, ResponseTime: 1 * time.Second},
-		{ID: "bob", Name: "Bob", SkillLevel: 0.5, ResponseTime: 2 * time.Second},
-		{ID: "charlie", Name: "Charlie", SkillLevel: 0.9, ResponseTime: 500 * time.Millisecond},
+		{Name: "Alice", SkillLevel: 0.7, ResponseTime: 1 * time.Second},
+		{Name: "Bob", SkillLevel: 0.5, ResponseTime: 2 * time.Second},
+		{Name: "Charlie", SkillLevel: 0.9, ResponseTime: 500 * time.Millisecond},
 	}
 
 	fmt.Printf("Joueurs IA participants:\n")
-	for _, p := range aiPlayers {
+	for i := range aiPlayers {
+		if err := aiPlayers[i].Register(ctx, service); err != nil {
+			fmt.Printf("Erreur inscription %s: %v\n", aiPlayers[i].Name, err)
+			return
+		}
 		fmt.Printf("  - %s (compétence: %.0f%%, vitesse: %v)\n",
-			p.Name, p.SkillLevel*100, p.ResponseTime)
+			aiPlayers[i].Name, aiPlayers[i].SkillLevel*100, aiPlayers[i].ResponseTime)
 	}
 	fmt.Println()
 
-	// Démarrer le spawner concurrent avec l'intervalle configuré
-	go StartSpawner(ctx, spawnCh, spawnInterval)
-
-	// Démarrer les joueurs IA
+	// Démarrer les joueurs IA (abonnés aux apparitions)
 	for _, player := range aiPlayers {
-		go player.StartPlayer(ctx, spawnCh, battleCh)
+		go player.StartPlayer(ctx, service, watchSpawns(service))
 	}
 
-	// Démarrer le gestionnaire de combat avec le timeout configuré
-	go BattleCoordinator(ctx, battleCh, fleeTimeout)
+	// Démarrer le spawner du service
+	go service.Start(ctx)
 
 	// Gérer l'arrêt propre avec Ctrl+C
 	sigChan := make(chan os.Signal, 1)
@@ -333,13 +397,40 @@ func runConcurrentMode(humanPlayer *core.Player, duration time.Duration) {
 
 	// Afficher les statistiques finales
 	fmt.Println("\n=== Statistiques Finales ===")
-	for _, aiPlayer := range aiPlayers {
-		fmt.Printf("  %s: Tentatives effectuées\n", aiPlayer.Name)
+	players, err := service.Leaderboard(context.Background(), 0)
+	if err != nil {
+		fmt.Printf("Erreur classement: %v\n", err)
+	}
+	for _, p := range players {
+		fmt.Printf("  %s: %d XP (niveau %d, %d captures)\n", p.Name, p.XP, p.Level, p.GetTotalCaptures())
 	}
 
 	fmt.Println("[Système] Retour au menu principal...")
 }
 
+// newLocalGame crée un service de jeu sur un store mémoire avec le catalogue de la config
+func newLocalGame() (*game.GameService, error) {
+	memStore := store.NewMemoryStore()
+	if err := memStore.Seed(context.Background(), game.WordsFromConfig(wordsConfig, gameConfig)); err != nil {
+		return nil, err
+	}
+	return game.NewGameService(memStore, gameConfig, nil), nil
+}
+
+// watchSpawns retourne un canal recevant les WordMon qui apparaissent
+func watchSpawns(service *game.GameService) <-chan core.Spawn {
+	ch := make(chan core.Spawn, 10)
+	service.Bus().Subscribe(func(event core.DomainEvent) {
+		created := event.(core.SpawnCreated)
+		select {
+		case ch <- core.Spawn{BattleID: created.BattleID, Word: created.Word}:
+		default:
+			// Joueur trop lent : l'apparition est ignorée
+		}
+	}, core.EventSpawnCreated)
+	return ch
+}
+
 // Types pour l'exercice 05
 type AIPlayer struct {
 	ID           string
@@ -348,70 +439,38 @@ type AIPlayer struct {
 	ResponseTime time.Duration
 }
 
-type PlayerAttempt struct {
-	PlayerName string
-	Answer     string
-	Word       core.Word
-	Timestamp  time.Time
-}
-
-// StartSpawner implémente le spawner concurrent (Exercice 05)
-func StartSpawner(ctx context.Context, ch chan<- core.Word, interval time.Duration) {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	fmt.Printf("[Spawner] Démarrage - spawn toutes les %v\n", interval)
-
-	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println("[Spawner] Arrêt propre du spawner")
-			close(ch)
-			return
-		case <-ticker.C:
-			word := core.SpawnWord()
-			fmt.Printf("[Spawner] Un WordMon apparaît: \"%s\" (%s, +%d XP)\n",
-				word.Text, word.Rarity, word.Points)
-
-			select {
-			case ch <- word:
-				// Envoyé avec succès
-			case <-ctx.Done():
-				fmt.Println("[Spawner] Arrêt propre du spawner")
-				close(ch)
-				return
-			}
-		}
+// Register inscrit le joueur IA auprès du service de jeu
+func (p *AIPlayer) Register(ctx context.Context, service *game.GameService) error {
+	player, err := service.CreatePlayer(ctx, p.Name)
+	if err != nil {
+		return err
 	}
+	p.ID = player.ID
+	return nil
 }
 
 // StartPlayer démarre un joueur IA
-func (p AIPlayer) StartPlayer(ctx context.Context, spawnCh <-chan core.Word, battleCh chan<- PlayerAttempt) {
+func (p AIPlayer) StartPlayer(ctx context.Context, service *game.GameService, spawnCh <-chan core.Spawn) {
 	for {
 		select {
 		case <-ctx.Done():
 			fmt.Printf("[%s] Joueur arrêté\n", p.Name)
 			return
-		case word, ok := <-spawnCh:
-			if !ok {
-				fmt.Printf("[%s] Canal fermé\n", p.Name)
-				return
-			}
-
+		case spawn := <-spawnCh:
 			// Décider de participer (90% de chance)
 			if rand.Float64() > 0.9 {
-				fmt.Printf("[%s] ignore \"%s\"\n", p.Name, word.Text)
+				fmt.Printf("[%s] ignore \"%s\"\n", p.Name, spawn.Word.Text)
 				continue
 			}
 
 			// Lancer la tentative en arrière-plan
-			go p.AttemptCapture(ctx, word, battleCh)
+			go p.AttemptCapture(ctx, service, spawn)
 		}
 	}
 }
 
-// AttemptCapture tente de capturer un WordMon
-func (p AIPlayer) AttemptCapture(ctx context.Context, word core.Word, battleCh chan<- PlayerAttempt) {
+// AttemptCapture tente de capturer un WordMon via le service de jeu
+func (p AIPlayer) AttemptCapture(ctx context.Context, service *game.GameService, spawn core.Spawn) {
 	// Simuler le temps de réflexion
 	delay := p.ResponseTime + time.Duration(rand.Intn(500))*time.Millisecond
 
@@ -419,27 +478,27 @@ func (p AIPlayer) AttemptCapture(ctx context.Context, word core.Word, battleCh c
 	case <-ctx.Done():
 		return
 	case <-time.After(delay):
-		// Générer une réponse
-		answer := p.GenerateAnswer(word.Text)
-
-		attempt := PlayerAttempt{
-			PlayerName: p.Name,
-			Answer:     answer,
-			Word:       word,
-			Timestamp:  time.Now(),
-		}
+	}
 
-		fmt.Printf("[%s] tente une capture avec réponse: \"%s\"\n",
-			p.Name, answer)
+	// Générer une réponse
+	answer := p.GenerateAnswer(spawn.Word.Text)
+	fmt.Printf("[%s] tente une capture avec réponse: \"%s\"\n", p.Name, answer)
 
-		select {
-		case battleCh <- attempt:
-			// Tentative envoyée
-		case <-ctx.Done():
-			return
-		case <-time.After(100 * time.Millisecond):
-			fmt.Printf("[%s] trop tard... le WordMon s'est échappé\n", p.Name)
-		}
+	result, err := service.Attempt(ctx, p.ID, spawn.BattleID, answer)
+	if err != nil {
+		fmt.Printf("[%s] trop tard... le WordMon s'est échappé\n", p.Name)
+		return
+	}
+
+	switch result.Status {
+	case game.StatusCaptured:
+		fmt.Printf("[Résultat] %s capture \"%s\" ! (+%d XP)\n", p.Name, result.Word.Text, result.XPGained)
+	case game.StatusWrong:
+		fmt.Printf("[Résultat] %s se trompe, \"%s\" reste en jeu\n", p.Name, result.Word.Text)
+	case game.StatusMissed:
+		fmt.Printf("[%s] trop tard... \"%s\" a été capturé\n", p.Name, result.Word.Text)
+	default:
+		fmt.Printf("[Résultat] Mauvaise tentative! \"%s\" s'enfuit...\n", result.Word.Text)
 	}
 }
 
@@ -476,132 +535,55 @@ func GenerateAnagram(word string) string {
 	return result
 }
 
-// BattleCoordinator gère les combats avec select (Exercice 05)
-func BattleCoordinator(ctx context.Context, battleCh <-chan PlayerAttempt, timeout time.Duration) {
-	fmt.Printf("[BattleCoordinator] Démarrage - timeout: %v\n", timeout)
-
-	// Map pour tracker les combats en cours
-	activeBattles := make(map[string]bool) // wordID -> combat en cours
+// handleEncounter gère une rencontre complète (Exercices 01-04) : un
+// WordMon apparaît et le dresseur tente de le capturer jusqu'à l'issue du
+// combat, selon la politique d'arbitrage de la config
+func handleEncounter(session *session, scanner *bufio.Scanner) {
+	ctx := context.Background()
+	spawn := session.service.PlaceSpawn(core.SpawnWord())
+	wordmon := core.NewWordMon(spawn.Word)
+	fmt.Println(wordmon.Presentation())
+	fmt.Println("Proposez un anagramme ('fuir' pour abandonner)")
 
 	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println("[BattleCoordinator] Arrêt du coordinateur")
+		fmt.Print("Votre tentative: ")
+		if !scanner.Scan() {
 			return
-		case attempt, ok := <-battleCh:
-			if !ok {
-				fmt.Println("[BattleCoordinator] Canal fermé")
-				return
-			}
-
-			wordID := attempt.Word.ID
-
-			// Vérifier si un combat est déjà résolu pour ce WordMon
-			if activeBattles[wordID] {
-				fmt.Printf("[%s] trop tard... le WordMon s'est échappé\n", attempt.PlayerName)
-				continue
-			}
-
-			// Marquer ce combat comme en cours
-			activeBattles[wordID] = true
-
-			// Traiter le combat
-			go HandleBattle(attempt, timeout)
 		}
-	}
-}
-
-// HandleBattle traite un combat individuel
-func HandleBattle(attempt PlayerAttempt, timeout time.Duration) {
-	fmt.Printf("[Battle] Combat pour \"%s\" - %s tente sa chance\n",
-		attempt.Word.Text, attempt.PlayerName)
-
-	// Créer le challenge
-	challenge := core.NewAnagramChallenge(attempt.Word)
-
-	// Vérifier la réponse
-	isCorrect, err := challenge.Check(attempt.Answer)
-
-	if err != nil {
-		fmt.Printf("[Résultat] Erreur: %v\n", err)
-		return
-	}
-
-	if isCorrect {
-		fmt.Printf("[Résultat] %s capture \"%s\" ! (+%d XP)\n",
-			attempt.PlayerName, attempt.Word.Text, attempt.Word.Points)
-	} else {
-		fmt.Printf("[Résultat] Mauvaise tentative! \"%s\" s'enfuit...\n", attempt.Word.Text)
-	}
-
-	fmt.Println("---")
-}
 
-// handleEncounter gère une rencontre complète (Exercices 01-04)
-func handleEncounter(encounter *core.Encounter, player *core.Player, scanner *bufio.Scanner) {
-	// Démarrer la rencontre
-	if err := encounter.Start(player); err != nil {
-		fmt.Printf("Erreur lors du démarrage: %v\n", err)
-		return
-	}
-
-	// Afficher les logs
-	for _, log := range encounter.GetBattleLog() {
-		fmt.Println(log)
-	}
-
-	// Commencer le combat
-	if err := encounter.BeginBattle(); err != nil {
-		fmt.Printf("Erreur lors du début du combat: %v\n", err)
-		return
-	}
-
-	// Afficher les nouvelles instructions
-	logs := encounter.GetBattleLog()
-	if len(logs) > 0 {
-		fmt.Println(logs[len(logs)-1])
-	}
-
-	// Boucle de combat
-	for encounter.GetCurrentState() == core.IN_BATTLE {
-		fmt.Print("Votre tentative: ")
-		if !scanner.Scan() {
-			break
+		answer := strings.TrimSpace(scanner.Text())
+		if answer == "fuir" {
+			fmt.Println("Vous prenez la fuite...")
+			return
 		}
 
-		attempt := strings.TrimSpace(scanner.Text())
-		if attempt == "" {
+		result, err := session.service.Attempt(ctx, session.playerID, spawn.BattleID, answer)
+		var invalid core.InvalidAttemptError
+		if errors.As(err, &invalid) {
 			fmt.Println("Veuillez entrer une tentative valide.")
 			continue
 		}
-
-		if err := encounter.SubmitAttempt(attempt); err != nil {
+		if err != nil {
 			fmt.Printf("Erreur: %v\n", err)
-			continue
+			return
 		}
 
-		// Afficher le dernier log
-		logs = encounter.GetBattleLog()
-		if len(logs) > 0 {
-			fmt.Println(logs[len(logs)-1])
+		switch result.Status {
+		case game.StatusCaptured:
+			fmt.Printf("Tentative '%s' → VICTOIRE ! Capture de '%s' : XP +%d, niveau = %d\n",
+				answer, result.Word.Text, result.XPGained, result.Player.Level)
+			return
+		case game.StatusWrong:
+			fmt.Printf("Tentative '%s' → ÉCHEC... le WordMon reste en jeu\n", answer)
+		default:
+			fmt.Printf("Tentative '%s' → ÉCHEC ! Le WordMon s'échappe dans un nuage de lettres...\n", answer)
+			return
 		}
 	}
-
-	// Résoudre la rencontre
-	if err := encounter.Resolve(); err != nil {
-		fmt.Printf("Erreur lors de la résolution: %v\n", err)
-		return
-	}
-
-	// Afficher le résultat final
-	logs = encounter.GetBattleLog()
-	if len(logs) > 0 {
-		fmt.Println(logs[len(logs)-1])
-	}
 }
 
 // runTestMode lance les scénarios de test automatiques
-func runTestMode(player *core.Player) {
+func runTestMode(session *session) {
 	fmt.Println("=== Mode Test Automatique (Tous exercices) ===")
 
 	// Test 1: Exercice 02 - Types et Spawning
@@ -610,11 +592,11 @@ func runTestMode(player *core.Player) {
 
 	// Test 2: Exercice 03 - Combat simple
 	fmt.Println("\n2. Test: Combat simple (Exercice 03)")
-	testCombat(player)
+	testCombat(session)
 
 	// Test 3: Exercice 04 - Gestion d'erreurs
 	fmt.Println("\n3. Test: Gestion d'erreurs (Exercice 04)")
-	testErrorHandling(player)
+	testErrorHandling(session)
 
 	// Test 4: Exercice 05 - Concurrence (court)
 	fmt.Println("\n4. Test: Concurrence (Exercice 05)")
@@ -631,52 +613,43 @@ func testSpawning() {
 	}
 }
 
-func testCombat(player *core.Player) {
+func testCombat(session *session) {
 	fmt.Println("  Test de combat automatique...")
-	encounter := core.NewEncounter()
-
-	if err := encounter.Start(player); err != nil {
-		fmt.Printf("    Erreur Start: %v\n", err)
-		return
-	}
-
-	if err := encounter.BeginBattle(); err != nil {
-		fmt.Printf("    Erreur BeginBattle: %v\n", err)
-		return
-	}
+	ctx := context.Background()
 
 	// Obtenir le mot et créer un anagramme simple
-	currentMon := encounter.GetCurrentMon()
-	if currentMon != nil {
-		word := currentMon.Word.Text
-		anagram := GenerateAnagram(word)
-		fmt.Printf("    Mot: %s, Anagramme généré: %s\n", word, anagram)
-
-		if err := encounter.SubmitAttempt(anagram); err != nil {
-			fmt.Printf("    Erreur SubmitAttempt: %v\n", err)
-		}
-	}
+	spawn := session.service.PlaceSpawn(core.SpawnWord())
+	anagram := GenerateAnagram(spawn.Word.Text)
+	fmt.Printf("    Mot: %s, Anagramme généré: %s\n", spawn.Word.Text, anagram)
 
-	if err := encounter.Resolve(); err != nil {
-		fmt.Printf("    Erreur Resolve: %v\n", err)
+	result, err := session.service.Attempt(ctx, session.playerID, spawn.BattleID, anagram)
+	if err != nil {
+		fmt.Printf("    Erreur Attempt: %v\n", err)
+		return
 	}
 
-	fmt.Printf("    Résultat: XP = %d, Niveau = %d\n", player.XP, player.Level)
+	fmt.Printf("    Résultat: %s, XP = %d, Niveau = %d\n", result.Status, result.Player.XP, result.Player.Level)
 }
 
-func testErrorHandling(player *core.Player) {
+func testErrorHandling(session *session) {
 	fmt.Println("  Test de gestion d'erreurs...")
+	ctx := context.Background()
 
-	// Test transition invalide
-	encounter := core.NewEncounter()
-	err := encounter.BeginBattle() // Sans Start()
-	if err != nil {
+	// Test tentative vide
+	spawn := session.service.PlaceSpawn(core.SpawnWord())
+	if _, err := session.service.Attempt(ctx, session.playerID, spawn.BattleID, ""); err != nil {
 		fmt.Printf("    Erreur détectée correctement: %v\n", err)
 	}
 
+	// Test combat remplacé
+	session.service.PlaceSpawn(core.SpawnWord())
+	if _, err := session.service.Attempt(ctx, session.playerID, spawn.BattleID, "abc"); err != nil {
+		fmt.Printf("    Combat clos détecté: %v\n", err)
+	}
+
 	// Test XP négative
-	err = player.AwardXP(-10)
-	if err != nil {
+	player := session.player()
+	if err := player.AwardXP(-10); err != nil {
 		fmt.Printf("    XP négative rejetée: %v\n", err)
 	}
 }
@@ -684,34 +657,31 @@ func testErrorHandling(player *core.Player) {
 func testConcurrency() {
 	fmt.Println("  Test de concurrence (5 secondes)...")
 
+	service, err := newLocalGame()
+	if err != nil {
+		fmt.Printf("    Erreur création du jeu: %v\n", err)
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	spawnCh := make(chan core.Word, 5)
-	battleCh := make(chan PlayerAttempt, 10)
+	// Joueur test
+	testPlayer := AIPlayer{Name: "TestBot", SkillLevel: 0.8, ResponseTime: 200 * time.Millisecond}
+	if err := testPlayer.Register(ctx, service); err != nil {
+		fmt.Printf("    Erreur inscription: %v\n", err)
+		return
+	}
+	go testPlayer.StartPlayer(ctx, service, watchSpawns(service))
 
 	// Spawner rapide pour test
 	go func() {
 		for i := 0; i < 3; i++ {
 			word := core.SpawnWord()
 			fmt.Printf("    [Test] WordMon: %s\n", word.Text)
-			spawnCh <- word
+			service.PlaceSpawn(word)
 			time.Sleep(1 * time.Second)
 		}
-		close(spawnCh)
-	}()
-
-	// Joueur test
-	testPlayer := AIPlayer{
-		ID: "test", Name: "TestBot", SkillLevel: 0.8, ResponseTime: 200 * time.Millisecond,
-	}
-	go testPlayer.StartPlayer(ctx, spawnCh, battleCh)
-
-	// Battle coordinator simple
-	go func() {
-		for attempt := range battleCh {
-			fmt.Printf("    [Test] %s répond: %s\n", attempt.PlayerName, attempt.Answer)
-		}
 	}()
 
 	<-ctx.Done()
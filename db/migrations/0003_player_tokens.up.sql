@@ -0,0 +1,9 @@
+CREATE TABLE player_tokens (
+    id UUID PRIMARY KEY,
+    player_id UUID NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX player_tokens_player_idx ON player_tokens (player_id);
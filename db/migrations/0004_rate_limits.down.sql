@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS rate_limit_blocks;
+
+DROP TABLE IF EXISTS rate_limit_counters;
+
+DROP TABLE IF EXISTS rate_limit_buckets;
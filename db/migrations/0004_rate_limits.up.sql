@@ -0,0 +1,16 @@
+CREATE TABLE rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE rate_limit_counters (
+    key TEXT PRIMARY KEY,
+    count INT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE rate_limit_blocks (
+    key TEXT PRIMARY KEY,
+    blocked_until TIMESTAMPTZ NOT NULL
+);
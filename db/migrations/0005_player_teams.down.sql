@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS players_xp_idx;
+
+DROP INDEX IF EXISTS players_team_idx;
+
+ALTER TABLE players DROP COLUMN IF EXISTS team;
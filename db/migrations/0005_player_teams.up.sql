@@ -0,0 +1,4 @@
+ALTER TABLE players ADD COLUMN team TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX players_team_idx ON players (team);
+CREATE INDEX players_xp_idx ON players (xp DESC, id);
@@ -0,0 +1,3 @@
+ALTER TABLE captures ALTER COLUMN word_id DROP NOT NULL;
+
+ALTER TABLE captures ALTER COLUMN player_id DROP NOT NULL;
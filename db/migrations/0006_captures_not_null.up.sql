@@ -0,0 +1,23 @@
+-- Les captures sans joueur ou sans mot ne sont pas supprimées ici : la
+-- migration échoue en les listant, un opérateur les examine puis les
+-- supprime avant de relancer wordmon migrate up.
+DO $$
+DECLARE
+    total INT;
+    sample TEXT;
+BEGIN
+    SELECT count(*) INTO total FROM captures WHERE player_id IS NULL OR word_id IS NULL;
+    IF total > 0 THEN
+        SELECT string_agg(format('%s (joueur %s, mot %s)', id, coalesce(player_id::text, 'NULL'), coalesce(word_id, 'NULL')), ', ')
+        INTO sample
+        FROM (SELECT id, player_id, word_id FROM captures
+              WHERE player_id IS NULL OR word_id IS NULL
+              ORDER BY captured_at LIMIT 20) AS orphan;
+        RAISE EXCEPTION '% capture(s) sans joueur ou sans mot: %', total, sample
+            USING HINT = 'après vérification: DELETE FROM captures WHERE player_id IS NULL OR word_id IS NULL; puis wordmon migrate up';
+    END IF;
+END $$;
+
+ALTER TABLE captures ALTER COLUMN player_id SET NOT NULL;
+
+ALTER TABLE captures ALTER COLUMN word_id SET NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS word_catalog_versions;
+
+ALTER TABLE words DROP COLUMN IF EXISTS retired_at;
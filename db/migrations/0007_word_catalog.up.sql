@@ -0,0 +1,11 @@
+ALTER TABLE words ADD COLUMN retired_at TIMESTAMPTZ;
+
+CREATE TABLE word_catalog_versions (
+    version INT PRIMARY KEY,
+    checksum TEXT NOT NULL,
+    added INT NOT NULL,
+    updated INT NOT NULL,
+    retired INT NOT NULL,
+    restored INT NOT NULL,
+    synced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,13 @@
+// Package migrations embarque les migrations SQL du schéma Postgres.
+// Chaque version NNNN_nom a un fichier .up.sql et un fichier .down.sql ;
+// une migration déjà appliquée ne doit plus être modifiée, sauf réécriture
+// sans effet sur le schéma obtenu, dont l'ancienne empreinte est alors
+// déclarée dans defaultPrevious (internal/migrate).
+package migrations
+
+import "embed"
+
+// Files fichiers de migration, à la racine du FS
+//
+//go:embed *.sql
+var Files embed.FS
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Clé du jeton authentifié dans le contexte Gin
+const authTokenKey = "authToken"
+
+// TokenResponse réponse des routes de jetons
+type TokenResponse struct {
+	Token   string `json:"token"`
+	TokenID string `json:"tokenId"`
+}
+
+// newIssuer crée l'émetteur de jetons des joueurs. Sans secret configuré, le
+// secret est lu depuis auth.secretFile, généré au premier démarrage : les
+// jetons conservés par le store restent valables d'un démarrage à l'autre.
+// Sans fichier non plus, le secret est éphémère.
+func newIssuer(gameConfig *config.GameConfig, tokens auth.TokenStore) (*auth.Issuer, error) {
+	if gameConfig.Auth.Secret != "" {
+		return auth.NewIssuer([]byte(gameConfig.Auth.Secret), tokens), nil
+	}
+
+	path := gameConfig.Auth.SecretFile
+	if path == "" {
+		secret, err := auth.NewSecret()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Println("[auth] WORDMON_AUTH_SECRET non défini : secret éphémère généré")
+		return auth.NewIssuer(secret, tokens), nil
+	}
+
+	secret, created, err := auth.LoadOrCreateSecret(path)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		fmt.Printf("[auth] WORDMON_AUTH_SECRET non défini : secret généré dans %s\n", path)
+	}
+	return auth.NewIssuer(secret, tokens), nil
+}
+
+// requirePlayer authentifie le joueur par "Authorization: Bearer <jeton>" et
+// place son jeton dans le contexte de la requête
+func requirePlayer(issuer *auth.Issuer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		raw, found := strings.CutPrefix(header, "Bearer ")
+		if !found || raw == "" {
+			c.Header("WWW-Authenticate", `Bearer realm="wordmon"`)
+			abortWithCode(c, http.StatusUnauthorized, CodeUnauthorized, "jeton requis")
+			return
+		}
+
+		token, err := issuer.Authenticate(c.Request.Context(), raw)
+		if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrRevokedToken) {
+			c.Header("WWW-Authenticate", `Bearer realm="wordmon", error="invalid_token"`)
+			abortWithCode(c, http.StatusUnauthorized, CodeUnauthorized, err.Error())
+			return
+		}
+		if err != nil {
+			// Stockage indisponible : 503, le client garde son jeton
+			abortWithError(c, err)
+			return
+		}
+
+		c.Set(authTokenKey, token)
+		c.Request = c.Request.WithContext(auth.WithToken(c.Request.Context(), token))
+		c.Next()
+	}
+}
+
+// authenticatedToken retourne le jeton posé par requirePlayer
+func authenticatedToken(c *gin.Context) *auth.Token {
+	token, _ := c.MustGet(authTokenKey).(*auth.Token)
+	return token
+}
+
+// attemptPlayerID retourne l'ID du joueur authentifié ; un playerId du corps
+// différent du jeton est refusé (403)
+func attemptPlayerID(c *gin.Context, bodyPlayerID string) (string, bool) {
+	playerID := authenticatedToken(c).PlayerID
+	if bodyPlayerID != "" && bodyPlayerID != playerID {
+		abortWithCode(c, http.StatusForbidden, CodeForbidden, "playerId ne correspond pas au jeton")
+		return "", false
+	}
+	return playerID, true
+}
+
+// registerAuthRoutes ajoute les routes de gestion des jetons du joueur authentifié
+func registerAuthRoutes(router gin.IRouter, issuer *auth.Issuer) {
+	group := router.Group("/auth", requirePlayer(issuer))
+
+	// Rotation : nouveau jeton, l'actuel est révoqué
+	group.POST("/token/rotate", func(c *gin.Context) {
+		raw, token, err := issuer.Rotate(c.Request.Context(), authenticatedToken(c))
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, TokenResponse{Token: raw, TokenID: token.ID})
+	})
+
+	// Révocation du jeton courant
+	group.DELETE("/token", func(c *gin.Context) {
+		if err := issuer.Revoke(c.Request.Context(), authenticatedToken(c).ID); err != nil {
+			abortWithError(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	// Révocation de tous les jetons du joueur (déconnexion partout)
+	group.DELETE("/tokens", func(c *gin.Context) {
+		if err := issuer.RevokeAll(c.Request.Context(), authenticatedToken(c).PlayerID); err != nil {
+			abortWithError(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+}
+
+// issuePlayerToken émet le jeton retourné à la création d'un joueur
+func issuePlayerToken(c *gin.Context, issuer *auth.Issuer, playerID string) (string, bool) {
+	raw, _, err := issuer.Issue(c.Request.Context(), playerID)
+	if err != nil {
+		abortWithError(c, fmt.Errorf("erreur émission jeton: %w", err))
+		return "", false
+	}
+	return raw, true
+}
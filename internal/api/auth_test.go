@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+// newTestServer crée un serveur mémoire avec un WordMon actif
+func newTestServer(t *testing.T) (*Server, *store.MemoryStore) {
+	t.Helper()
+	ctx := t.Context()
+	gameConfig := &config.GameConfig{
+		Spawner: config.SpawnerConfig{AutoFleeAfterSeconds: 5},
+		Battle:  config.BattleConfig{Policy: "first-attempt-wins"},
+		Auth:    config.AuthConfig{Secret: "test"},
+	}
+	memStore := store.NewMemoryStore()
+	word := core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}
+	memStore.Seed(ctx, []core.Word{word})
+	server, err := NewServer(memStore, gameConfig, nil)
+	if err != nil {
+		t.Fatalf("serveur: %v", err)
+	}
+	server.Game().PlaceSpawn(word)
+	return server, memStore
+}
+
+// doJSON exécute une requête JSON sur le routeur du serveur
+func doJSON(server *Server, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	server.router.ServeHTTP(w, req)
+	return w
+}
+
+// createTestPlayer crée un joueur et retourne sa réponse (avec jeton)
+func createTestPlayer(t *testing.T, server *Server, name string) PlayerJSON {
+	t.Helper()
+	w := doJSON(server, http.MethodPost, "/players", "", `{"name":"`+name+`"}`)
+	var player PlayerJSON
+	if err := json.Unmarshal(w.Body.Bytes(), &player); err != nil || player.Token == "" {
+		t.Fatalf("création joueur: %d %s", w.Code, w.Body.String())
+	}
+	return player
+}
+
+func TestAttemptRequiresBearerToken(t *testing.T) {
+	server, _ := newTestServer(t)
+	alice := createTestPlayer(t, server, "Alice")
+	bob := createTestPlayer(t, server, "Bob")
+
+	if w := doJSON(server, http.MethodPost, "/encounter/attempt", "", `{"playerId":"`+alice.ID+`","attempt":"tach"}`); w.Code != http.StatusUnauthorized {
+		t.Errorf("sans jeton: attendu 401, obtenu %d", w.Code)
+	}
+
+	// Bob ne peut pas jouer pour Alice
+	if w := doJSON(server, http.MethodPost, "/encounter/attempt", bob.Token, `{"playerId":"`+alice.ID+`","attempt":"tach"}`); w.Code != http.StatusForbidden {
+		t.Errorf("jeton d'un autre joueur: attendu 403, obtenu %d", w.Code)
+	}
+
+	w := doJSON(server, http.MethodPost, "/encounter/attempt", alice.Token, `{"attempt":"tach"}`)
+	var response AttemptResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if w.Code != http.StatusOK || response.Status != "captured" {
+		t.Errorf("capture attendue: %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestRotatedTokenReplacesPrevious(t *testing.T) {
+	server, _ := newTestServer(t)
+	alice := createTestPlayer(t, server, "Alice")
+
+	w := doJSON(server, http.MethodPost, "/auth/token/rotate", alice.Token, "")
+	var rotated TokenResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &rotated); err != nil || rotated.Token == "" {
+		t.Fatalf("rotation: %d %s", w.Code, w.Body.String())
+	}
+
+	if w := doJSON(server, http.MethodPost, "/encounter/attempt", alice.Token, `{"attempt":"tach"}`); w.Code != http.StatusUnauthorized {
+		t.Errorf("ancien jeton: attendu 401, obtenu %d", w.Code)
+	}
+
+	if w := doJSON(server, http.MethodDelete, "/auth/token", rotated.Token, ""); w.Code != http.StatusNoContent {
+		t.Errorf("révocation: attendu 204, obtenu %d", w.Code)
+	}
+	if w := doJSON(server, http.MethodPost, "/encounter/attempt", rotated.Token, `{"attempt":"tach"}`); w.Code != http.StatusUnauthorized {
+		t.Errorf("jeton révoqué: attendu 401, obtenu %d", w.Code)
+	}
+}
+
+// brokenTokens store dont les jetons sont inaccessibles quand down est vrai
+type brokenTokens struct {
+	*store.MemoryStore
+	down bool
+}
+
+func (s *brokenTokens) SaveToken(ctx context.Context, t *auth.Token) error {
+	if s.down {
+		return fmt.Errorf("écriture jeton: %w", store.ErrUnavailable)
+	}
+	return s.MemoryStore.SaveToken(ctx, t)
+}
+
+func (s *brokenTokens) GetToken(ctx context.Context, id string) (*auth.Token, error) {
+	if s.down {
+		return nil, fmt.Errorf("lecture jeton: %w", store.ErrUnavailable)
+	}
+	return s.MemoryStore.GetToken(ctx, id)
+}
+
+func TestTokenStoreOutage(t *testing.T) {
+	tokens := &brokenTokens{MemoryStore: store.NewMemoryStore()}
+	server, err := NewServer(tokens, &config.GameConfig{Auth: config.AuthConfig{Secret: "test"}}, nil)
+	if err != nil {
+		t.Fatalf("serveur: %v", err)
+	}
+	alice := createTestPlayer(t, server, "Alice")
+
+	// Panne : le jeton reste valable, le client ne doit pas l'oublier
+	tokens.down = true
+	if w := doJSON(server, http.MethodPost, "/v1/auth/token/rotate", alice.Token, ""); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("503 attendu pendant la panne, obtenu %d %s", w.Code, w.Body.String())
+	}
+
+	// Joueur créé sans jeton : supprimé, son nom reste libre
+	if w := doJSON(server, http.MethodPost, "/players", "", `{"name":"Bob"}`); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("503 attendu à la création, obtenu %d %s", w.Code, w.Body.String())
+	}
+	tokens.down = false
+	createTestPlayer(t, server, "Bob")
+}
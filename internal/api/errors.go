@@ -0,0 +1,186 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/game"
+	"github.com/SamG1008/wordmon-go/internal/store"
+	"github.com/gin-gonic/gin"
+)
+
+// Codes d'erreur stables de l'API (champ error.code de l'enveloppe /v1)
+const (
+	CodeInvalidRequest  = "invalid_request"
+	CodeInvalidAttempt  = "invalid_attempt"
+	CodeInvalidState    = "invalid_state"
+	CodeCaptureFailed   = "capture_failed"
+	CodeInvalidXP       = "invalid_xp"
+	CodeChallengeFailed = "challenge_failed"
+	CodeBattleClosed    = "battle_closed"
+	CodePlayerNotFound  = "player_not_found"
+	CodeNoActiveSpawn   = "no_active_spawn"
+	CodeNotFound        = "not_found"
+	CodeConflict        = "conflict"
+	CodeUnauthorized    = "unauthorized"
+	CodeForbidden       = "forbidden"
+	CodeRateLimited     = "rate_limited"
+	CodeUnavailable     = "unavailable"
+	CodeInternal        = "internal"
+)
+
+// En-tête portant l'identifiant de la requête
+const requestIDHeader = "X-Request-ID"
+
+// Clés du contexte Gin
+const (
+	requestIDKey = "requestID"
+	envelopeKey  = "errorEnvelope"
+)
+
+// APIError erreur de l'API : statut HTTP, code stable, message et détails
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+	Details any
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// ErrorEnvelope corps JSON des erreurs des routes /v1
+type ErrorEnvelope struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody contenu de l'enveloppe d'erreur
+type ErrorBody struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Details   any    `json:"details,omitempty"`
+	RequestID string `json:"requestId"`
+}
+
+// apiErrorFrom associe une erreur du jeu à son statut HTTP et à son code
+func apiErrorFrom(err error) *APIError {
+	var (
+		apiErr    *APIError
+		attempt   core.InvalidAttemptError
+		state     core.InvalidStateError
+		capture   core.CaptureError
+		xp        core.XPError
+		challenge core.ChallengeError
+		closed    core.BattleClosedError
+	)
+
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr
+	case errors.As(err, &attempt):
+		return &APIError{http.StatusBadRequest, CodeInvalidAttempt, err.Error(), gin.H{"reason": attempt.Reason}}
+	case errors.As(err, &challenge):
+		return &APIError{http.StatusUnprocessableEntity, CodeChallengeFailed, err.Error(), gin.H{"reason": challenge.Reason}}
+	case errors.As(err, &xp):
+		return &APIError{http.StatusUnprocessableEntity, CodeInvalidXP, err.Error(), gin.H{"points": xp.Points}}
+	case errors.As(err, &state):
+		return &APIError{http.StatusConflict, CodeInvalidState, err.Error(), gin.H{"state": state.From, "expected": state.Expected}}
+	case errors.As(err, &capture):
+		return &APIError{http.StatusConflict, CodeCaptureFailed, err.Error(), gin.H{"word": capture.Word}}
+	case errors.As(err, &closed):
+		return &APIError{http.StatusConflict, CodeBattleClosed, err.Error(), gin.H{"spawnId": closed.BattleID}}
+	case errors.Is(err, game.ErrPlayerNotFound):
+		return &APIError{http.StatusNotFound, CodePlayerNotFound, game.ErrPlayerNotFound.Error(), nil}
+	case errors.Is(err, game.ErrNoSpawn):
+		return &APIError{http.StatusNotFound, CodeNoActiveSpawn, err.Error(), nil}
+	case errors.Is(err, game.ErrInvalidName):
+		return &APIError{http.StatusBadRequest, CodeInvalidRequest, err.Error(), nil}
+	case errors.Is(err, store.ErrNotFound):
+		return &APIError{http.StatusNotFound, CodeNotFound, err.Error(), nil}
+	case errors.Is(err, store.ErrConflict):
+		return &APIError{http.StatusConflict, CodeConflict, err.Error(), nil}
+	case errors.Is(err, store.ErrInvalid):
+		return &APIError{http.StatusBadRequest, CodeInvalidRequest, err.Error(), nil}
+	case errors.Is(err, store.ErrUnavailable):
+		return &APIError{http.StatusServiceUnavailable, CodeUnavailable, store.ErrUnavailable.Error(), nil}
+	default:
+		return &APIError{http.StatusInternalServerError, CodeInternal, "erreur interne", nil}
+	}
+}
+
+// abortWithError interrompt la requête avec l'erreur err
+func abortWithError(c *gin.Context, err error) {
+	apiErr := apiErrorFrom(err)
+	if apiErr.Status >= http.StatusInternalServerError {
+		// La cause (driver, réseau) n'apparaît que dans le journal
+		fmt.Printf("[api] %s %s (requête %s): %v\n", c.Request.Method, c.Request.URL.Path, c.GetString(requestIDKey), err)
+	}
+
+	// Routes /v1 : enveloppe stable
+	if c.GetBool(envelopeKey) {
+		c.AbortWithStatusJSON(apiErr.Status, ErrorEnvelope{Error: ErrorBody{
+			Code:      apiErr.Code,
+			Message:   apiErr.Message,
+			Details:   apiErr.Details,
+			RequestID: c.GetString(requestIDKey),
+		}})
+		return
+	}
+
+	// Routes historiques : {"error": message} et détails à plat
+	body := gin.H{}
+	if details, ok := apiErr.Details.(gin.H); ok {
+		for key, value := range details {
+			body[key] = value
+		}
+	}
+	body["error"] = apiErr.Message
+	c.AbortWithStatusJSON(apiErr.Status, body)
+}
+
+// abortWithCode interrompt la requête avec un statut, un code et un message
+func abortWithCode(c *gin.Context, status int, code, message string) {
+	abortWithError(c, &APIError{Status: status, Code: code, Message: message})
+}
+
+// validRequestID limite les identifiants fournis par le client
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// requestID reprend l'en-tête X-Request-ID du client (ou en génère un) et le renvoie
+func requestID(c *gin.Context) {
+	id := c.GetHeader(requestIDHeader)
+	if !validRequestID.MatchString(id) {
+		id = newRequestID()
+	}
+	c.Set(requestIDKey, id)
+	c.Header(requestIDHeader, id)
+	c.Next()
+}
+
+// newRequestID génère un identifiant de requête aléatoire
+func newRequestID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf)
+}
+
+// useErrorEnvelope active l'enveloppe d'erreur des routes /v1
+func useErrorEnvelope(c *gin.Context) {
+	c.Set(envelopeKey, true)
+	c.Next()
+}
+
+// deprecatedRoute signale les routes historiques, remplacées par /v1
+func deprecatedRoute(c *gin.Context) {
+	c.Header("Deprecation", "true")
+	c.Header("Link", fmt.Sprintf("</v1%s>; rel=\"successor-version\"", c.Request.URL.Path))
+	c.Next()
+}
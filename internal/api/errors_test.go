@@ -0,0 +1,69 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestV1ErrorEnvelope(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/players/inconnu", nil)
+	req.Header.Set(requestIDHeader, "req-42")
+	w := httptest.NewRecorder()
+	server.router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("attendu 404, obtenu %d", w.Code)
+	}
+	var envelope ErrorEnvelope
+	if err := json.Unmarshal(w.Body.Bytes(), &envelope); err != nil {
+		t.Fatalf("enveloppe invalide: %v", err)
+	}
+	if envelope.Error.Code != CodePlayerNotFound || envelope.Error.RequestID != "req-42" || envelope.Error.Message == "" {
+		t.Errorf("enveloppe inattendue: %s", w.Body.String())
+	}
+	if w.Header().Get(requestIDHeader) != "req-42" {
+		t.Errorf("X-Request-ID non renvoyé: %q", w.Header().Get(requestIDHeader))
+	}
+}
+
+func TestV1MapsCoreErrors(t *testing.T) {
+	server, _ := newTestServer(t)
+	alice := createTestPlayer(t, server, "Alice")
+
+	tests := []struct {
+		body   string
+		status int
+		code   string
+	}{
+		{`{"attempt":"   "}`, http.StatusBadRequest, CodeInvalidAttempt},
+		{`{"attempt":"tach","spawnId":"b999"}`, http.StatusConflict, CodeBattleClosed},
+		{`{"attempt":"tach","playerId":"autre"}`, http.StatusForbidden, CodeForbidden},
+	}
+
+	for _, tt := range tests {
+		w := doJSON(server, http.MethodPost, "/v1/encounter/attempt", alice.Token, tt.body)
+		var envelope ErrorEnvelope
+		json.Unmarshal(w.Body.Bytes(), &envelope)
+		if w.Code != tt.status || envelope.Error.Code != tt.code {
+			t.Errorf("%s: attendu %d %s, obtenu %d %s", tt.body, tt.status, tt.code, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestLegacyRoutesKeepErrorFormat(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	w := doJSON(server, http.MethodGet, "/players/inconnu", "", "")
+	var body map[string]any
+	json.Unmarshal(w.Body.Bytes(), &body)
+	if w.Code != http.StatusNotFound || body["error"] != "joueur non trouvé" {
+		t.Errorf("format historique attendu: %d %s", w.Code, w.Body.String())
+	}
+	if w.Header().Get("Deprecation") != "true" {
+		t.Error("en-tête Deprecation attendu sur les routes historiques")
+	}
+}
@@ -0,0 +1,329 @@
+package api
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// Types d'événements poussés aux clients
+const (
+	EventSpawn   = "spawn"
+	EventFlee    = "flee"
+	EventCapture = "capture"
+	EventLevelUp = "level_up"
+	EventReset   = "reset" // reprise impossible depuis le dernier ID reçu
+)
+
+// Raisons d'un événement reset
+const (
+	ResetExpired = "expired" // événements sortis de l'historique
+	ResetRestart = "restart" // ID d'un démarrage précédent du serveur
+	ResetUnknown = "unknown" // ID jamais attribué
+)
+
+// eventSeqBits bits de poids faible d'un ID d'événement réservés au compteur ;
+// les bits de poids fort portent l'heure de démarrage (secondes Unix), ce qui
+// rend les IDs croissants d'un démarrage à l'autre et sûrs en JavaScript
+const eventSeqBits = 20
+
+// Event représente un événement de jeu poussé aux clients (SSE ou WebSocket)
+type Event struct {
+	ID       uint64      `json:"id"`
+	Type     string      `json:"type"`
+	PlayerID string      `json:"playerId,omitempty"`
+	Time     time.Time   `json:"time"`
+	Data     interface{} `json:"data"`
+}
+
+// SpawnEventData contenu d'un événement spawn
+type SpawnEventData struct {
+	Word WordJSON `json:"word"`
+}
+
+// FleeEventData contenu d'un événement flee
+type FleeEventData struct {
+	Word   WordJSON `json:"word"`
+	Reason string   `json:"reason"`
+}
+
+// CaptureEventData contenu d'un événement capture
+type CaptureEventData struct {
+	PlayerName string   `json:"playerName"`
+	Word       WordJSON `json:"word"`
+	XPGained   int      `json:"xpGained"`
+	XP         int      `json:"xp"`
+	Level      int      `json:"level"`
+}
+
+// ResetEventData contenu d'un événement reset : le client a manqué des
+// événements et doit recharger l'état (joueur, classement, spawn) avant de
+// suivre le flux
+type ResetEventData struct {
+	LastEventID uint64 `json:"lastEventId"`
+	Reason      string `json:"reason"`
+}
+
+// LevelUpEventData contenu d'un événement level_up
+type LevelUpEventData struct {
+	PlayerName    string `json:"playerName"`
+	PreviousLevel int    `json:"previousLevel"`
+	Level         int    `json:"level"`
+}
+
+// EventFilter sélectionne les événements d'un abonné.
+// Les événements sans joueur (spawn, flee) passent le filtre joueur.
+type EventFilter struct {
+	PlayerID string
+	Types    map[string]bool
+}
+
+// ParseEventFilter construit un filtre depuis les paramètres player et types (séparés par des virgules)
+func ParseEventFilter(playerID, types string) EventFilter {
+	filter := EventFilter{PlayerID: playerID}
+	for _, t := range strings.Split(types, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		if filter.Types == nil {
+			filter.Types = make(map[string]bool)
+		}
+		filter.Types[t] = true
+	}
+	return filter
+}
+
+// Match indique si l'événement passe le filtre ; un reset passe toujours
+func (f EventFilter) Match(e Event) bool {
+	if e.Type == EventReset {
+		return true
+	}
+	if len(f.Types) > 0 && !f.Types[e.Type] {
+		return false
+	}
+	if f.PlayerID != "" && e.PlayerID != "" && e.PlayerID != f.PlayerID {
+		return false
+	}
+	return true
+}
+
+// eventSubscriber abonné du hub avec sa file d'envoi
+type eventSubscriber struct {
+	ch     chan Event
+	filter EventFilter
+}
+
+// EventHub diffuse les événements aux abonnés et conserve un historique
+// borné pour permettre la reprise depuis le dernier ID reçu.
+type EventHub struct {
+	mu          sync.Mutex
+	firstID     uint64 // premier ID de ce démarrage
+	nextID      uint64
+	history     []Event
+	capacity    int
+	subscribers map[*eventSubscriber]struct{}
+}
+
+// NewEventHub crée un hub conservant les capacity derniers événements
+func NewEventHub(capacity int) *EventHub {
+	return newEventHubAt(capacity, time.Now())
+}
+
+// newEventHubAt crée un hub démarré à boot : ses IDs commencent après ceux
+// de tout hub démarré avant
+func newEventHubAt(capacity int, boot time.Time) *EventHub {
+	firstID := uint64(boot.Unix())<<eventSeqBits + 1
+	return &EventHub{
+		firstID:     firstID,
+		nextID:      firstID,
+		capacity:    capacity,
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// Publish horodate, numérote et diffuse un événement
+func (h *EventHub) Publish(eventType, playerID string, data interface{}) Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	event := Event{
+		ID:       h.nextID,
+		Type:     eventType,
+		PlayerID: playerID,
+		Time:     time.Now().UTC(),
+		Data:     data,
+	}
+	h.nextID++
+
+	h.history = append(h.history, event)
+	if len(h.history) > h.capacity {
+		h.history = h.history[len(h.history)-h.capacity:]
+	}
+
+	for sub := range h.subscribers {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			// Abonné trop lent : il est déconnecté et reprendra via son dernier ID
+			delete(h.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+
+	return event
+}
+
+// Close déconnecte tous les abonnés (arrêt du serveur) ; ils reprendront
+// via leur dernier ID
+func (h *EventHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subscribers {
+		delete(h.subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+// Subscribe retourne les événements manqués depuis lastID puis le canal des
+// suivants. Si lastID ne peut pas être servi (historique dépassé, autre
+// démarrage, ID inconnu), replay commence par un événement reset suivi de
+// tout l'historique. cancel doit être appelé à la déconnexion du client.
+func (h *EventHub) Subscribe(filter EventFilter, lastID uint64) (replay []Event, events <-chan Event, cancel func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if lastID > 0 {
+		if reason := h.gap(lastID); reason != "" {
+			replay = append(replay, h.reset(lastID, reason))
+			lastID = 0
+		}
+		for _, event := range h.history {
+			if event.ID > lastID && filter.Match(event) {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	sub := &eventSubscriber{
+		ch:     make(chan Event, 64),
+		filter: filter,
+	}
+	h.subscribers[sub] = struct{}{}
+
+	cancel = func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, exists := h.subscribers[sub]; exists {
+			delete(h.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+
+	return replay, sub.ch, cancel
+}
+
+// gap indique pourquoi la reprise après lastID est impossible ("" si elle
+// l'est) ; verrou requis
+func (h *EventHub) gap(lastID uint64) string {
+	switch {
+	case lastID < h.firstID-1:
+		return ResetRestart
+	case lastID >= h.nextID:
+		return ResetUnknown
+	case len(h.history) > 0 && lastID < h.history[0].ID-1:
+		return ResetExpired
+	}
+	return ""
+}
+
+// reset construit l'événement reset placé avant l'historique : son ID
+// précède le plus ancien événement conservé, que le client reçoit ensuite ;
+// verrou requis
+func (h *EventHub) reset(lastID uint64, reason string) Event {
+	id := h.nextID - 1
+	if len(h.history) > 0 {
+		id = h.history[0].ID - 1
+	}
+	return Event{
+		ID:   id,
+		Type: EventReset,
+		Time: time.Now().UTC(),
+		Data: ResetEventData{LastEventID: lastID, Reason: reason},
+	}
+}
+
+// HandleDomainEvent traduit un événement du bus en événement poussé aux clients
+func (h *EventHub) HandleDomainEvent(e core.DomainEvent) {
+	switch ev := e.(type) {
+	case core.SpawnCreated:
+		h.PublishSpawn(&ev.Word)
+	case core.SpawnFled:
+		h.PublishFlee(&ev.Word, ev.Reason)
+	case core.WordCaptured:
+		h.Publish(EventCapture, ev.PlayerID, CaptureEventData{
+			PlayerName: ev.PlayerName,
+			Word:       CoreWordToJSON(&ev.Word),
+			XPGained:   ev.XPGained,
+			XP:         ev.XP,
+			Level:      ev.Level,
+		})
+	case core.LevelUp:
+		h.Publish(EventLevelUp, ev.PlayerID, LevelUpEventData{
+			PlayerName:    ev.PlayerName,
+			PreviousLevel: ev.PreviousLevel,
+			Level:         ev.Level,
+		})
+	}
+}
+
+// attachEventBus abonne au bus le hub des clients et les compteurs d'événements.
+// Un bus nil est remplacé par un bus local au serveur.
+func attachEventBus(bus *core.EventBus) (*core.EventBus, *EventHub, *core.EventMetrics) {
+	if bus == nil {
+		bus = core.NewEventBus()
+	}
+
+	hub := NewEventHub(256)
+	bus.SubscribeAsync(256, hub.HandleDomainEvent,
+		core.EventSpawnCreated, core.EventSpawnFled, core.EventWordCaptured, core.EventLevelUp)
+
+	metrics := core.NewEventMetrics()
+	bus.Subscribe(metrics.Handle)
+
+	return bus, hub, metrics
+}
+
+// PublishSpawn annonce l'apparition d'un WordMon
+func (h *EventHub) PublishSpawn(word *core.Word) {
+	h.Publish(EventSpawn, "", SpawnEventData{Word: CoreWordToJSON(word)})
+}
+
+// PublishFlee annonce la fuite d'un WordMon
+func (h *EventHub) PublishFlee(word *core.Word, reason string) {
+	h.Publish(EventFlee, "", FleeEventData{Word: CoreWordToJSON(word), Reason: reason})
+}
+
+// PublishCapture annonce une capture et, si le niveau change, un level_up
+func (h *EventHub) PublishCapture(player *core.Player, word *core.Word, previousLevel int) {
+	h.Publish(EventCapture, player.ID, CaptureEventData{
+		PlayerName: player.Name,
+		Word:       CoreWordToJSON(word),
+		XPGained:   word.Points,
+		XP:         player.XP,
+		Level:      player.Level,
+	})
+
+	if player.Level > previousLevel {
+		h.Publish(EventLevelUp, player.ID, LevelUpEventData{
+			PlayerName:    player.Name,
+			PreviousLevel: previousLevel,
+			Level:         player.Level,
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/gin-gonic/gin"
+)
+
+func TestEventHubResumeFromLastID(t *testing.T) {
+	hub := NewEventHub(10)
+	word := &core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}
+	alice := &core.Player{ID: "p1", Name: "Alice", XP: 100, Level: 2}
+
+	first := hub.Publish(EventSpawn, "", SpawnEventData{Word: CoreWordToJSON(word)})
+	hub.PublishCapture(alice, word, 1)
+	hub.PublishFlee(word, core.VerdictTimeout)
+
+	replay, _, cancel := hub.Subscribe(EventFilter{}, first.ID)
+	defer cancel()
+
+	var types []string
+	for _, e := range replay {
+		types = append(types, e.Type)
+	}
+	if got := strings.Join(types, ","); got != "capture,level_up,flee" {
+		t.Errorf("reprise inattendue: %s", got)
+	}
+}
+
+func TestEventHubCloseEndsSubscriptions(t *testing.T) {
+	hub := NewEventHub(10)
+	_, events, cancel := hub.Subscribe(EventFilter{}, 0)
+
+	hub.Close()
+	if _, ok := <-events; ok {
+		t.Error("canal fermé attendu après Close")
+	}
+	cancel() // sans effet après Close
+}
+
+func TestEventFilterByPlayerAndType(t *testing.T) {
+	hub := NewEventHub(10)
+	word := &core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}
+
+	_, events, cancel := hub.Subscribe(ParseEventFilter("p1", "capture,spawn"), 0)
+	defer cancel()
+
+	hub.PublishSpawn(word)
+	hub.PublishCapture(&core.Player{ID: "p2", Name: "Bob", Level: 1}, word, 1)
+	hub.PublishCapture(&core.Player{ID: "p1", Name: "Alice", Level: 2}, word, 1)
+
+	expected := []string{EventSpawn, EventCapture}
+	for _, want := range expected {
+		select {
+		case e := <-events:
+			if e.Type != want || (e.PlayerID != "" && e.PlayerID != "p1") {
+				t.Errorf("événement inattendu: %+v", e)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("événement %s non reçu", want)
+		}
+	}
+	select {
+	case e := <-events:
+		t.Errorf("événement non filtré: %+v", e)
+	default:
+	}
+}
+
+func TestServeSSEReplaysMissedEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	hub := NewEventHub(10)
+	word := &core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}
+	spawn := hub.Publish(EventSpawn, "", SpawnEventData{Word: CoreWordToJSON(word)})
+	hub.PublishFlee(word, core.VerdictTimeout)
+
+	router := gin.New()
+	registerEventRoutes(router, hub, core.NewEventMetrics(), newUpgrader(""))
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/events/stream", nil)
+	req.Header.Set("Last-Event-ID", strconv.FormatUint(spawn.ID, 10))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type inattendu: %s", ct)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 2 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	if lines[0] != fmt.Sprintf("id: %d", spawn.ID+1) || lines[1] != "event: flee" {
+		t.Errorf("flux SSE inattendu: %v", lines)
+	}
+}
+
+func TestEventHubResetWhenLastIDCannotBeServed(t *testing.T) {
+	boot := time.Now()
+	word := &core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}
+
+	previous := newEventHubAt(2, boot.Add(-time.Hour))
+	stale := previous.Publish(EventSpawn, "", SpawnEventData{Word: CoreWordToJSON(word)})
+
+	hub := newEventHubAt(2, boot)
+	first := hub.Publish(EventSpawn, "", SpawnEventData{Word: CoreWordToJSON(word)})
+	if first.ID <= stale.ID {
+		t.Fatalf("IDs non croissants d'un démarrage à l'autre: %d puis %d", stale.ID, first.ID)
+	}
+	hub.PublishFlee(word, core.VerdictTimeout)
+	last := hub.Publish(EventSpawn, "", SpawnEventData{Word: CoreWordToJSON(word)}) // first sort de l'historique
+
+	for _, tc := range []struct {
+		lastID uint64
+		reason string
+	}{
+		{stale.ID, ResetRestart},
+		{first.ID - 1, ResetExpired},
+		{last.ID + 100, ResetUnknown},
+	} {
+		replay, _, cancel := hub.Subscribe(ParseEventFilter("", EventCapture), tc.lastID)
+		cancel()
+		if len(replay) != 1 || replay[0].Type != EventReset {
+			t.Errorf("lastID %d: reset seul attendu (filtre capture), obtenu %+v", tc.lastID, replay)
+			continue
+		}
+		if data := replay[0].Data.(ResetEventData); data.Reason != tc.reason || data.LastEventID != tc.lastID {
+			t.Errorf("lastID %d: reset inattendu %+v", tc.lastID, data)
+		}
+	}
+
+	// Reprise après le reset : l'historique conservé suit, à partir de son ID
+	replay, _, cancel := hub.Subscribe(EventFilter{}, stale.ID)
+	defer cancel()
+	if len(replay) != 3 || replay[0].ID != replay[1].ID-1 || replay[2].ID != last.ID {
+		t.Errorf("reset puis historique attendus: %+v", replay)
+	}
+}
+
+func TestWebSocketOriginCheck(t *testing.T) {
+	upgrader := newUpgrader("https://jeu.example, http://localhost:3000/")
+	for origin, want := range map[string]bool{
+		"":                        true, // client hors navigateur
+		"http://api.example":      true, // même origine
+		"https://jeu.example":     true,
+		"http://localhost:3000":   true,
+		"https://evil.example":    false,
+		"http://api.example.evil": false,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "http://api.example/events/ws", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if got := upgrader.CheckOrigin(req); got != want {
+			t.Errorf("origine %q: %v, attendu %v", origin, got, want)
+		}
+	}
+	if !newUpgrader("*").CheckOrigin(&http.Request{Host: "a", Header: http.Header{"Origin": {"https://b"}}}) {
+		t.Error("\"*\" doit accepter toutes les origines")
+	}
+}
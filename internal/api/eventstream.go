@@ -0,0 +1,175 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// Intervalle des messages de maintien de connexion
+const streamHeartbeat = 15 * time.Second
+
+// newUpgrader crée l'upgrader WebSocket. Une page web ne peut ouvrir le flux
+// que depuis la même origine que l'API ou une origine de allowedOrigins
+// (liste séparée par des virgules, "*" : toutes) ; les clients hors
+// navigateur n'envoient pas d'en-tête Origin et sont acceptés.
+func newUpgrader(allowedOrigins string) *websocket.Upgrader {
+	allowed := map[string]bool{}
+	for _, origin := range strings.Split(allowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowed[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+		}
+	}
+
+	return &websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			if origin == "" || allowed["*"] || allowed[strings.ToLower(origin)] {
+				return true
+			}
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		},
+	}
+}
+
+// registerEventRoutes ajoute les routes de flux d'événements et des compteurs
+func registerEventRoutes(router gin.IRoutes, hub *EventHub, metrics *core.EventMetrics, upgrader *websocket.Upgrader) {
+	router.GET("/events/stream", hub.ServeSSE)
+	router.GET("/events/ws", hub.ServeWebSocket(upgrader))
+	router.GET("/events/metrics", func(c *gin.Context) {
+		c.JSON(http.StatusOK, metrics.Snapshot())
+	})
+}
+
+// subscribeFromRequest lit le filtre (?player=&types=) et le dernier ID reçu
+// (en-tête Last-Event-ID ou ?lastEventId=) puis abonne le client
+func (h *EventHub) subscribeFromRequest(c *gin.Context) ([]Event, <-chan Event, func()) {
+	filter := ParseEventFilter(c.Query("player"), c.Query("types"))
+
+	lastIDStr := c.GetHeader("Last-Event-ID")
+	if lastIDStr == "" {
+		lastIDStr = c.Query("lastEventId")
+	}
+	lastID, _ := strconv.ParseUint(lastIDStr, 10, 64)
+
+	return h.Subscribe(filter, lastID)
+}
+
+// ServeSSE diffuse les événements en Server-Sent Events (GET /events/stream)
+func (h *EventHub) ServeSSE(c *gin.Context) {
+	replay, events, cancel := h.subscribeFromRequest(c)
+	defer cancel()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+
+	for _, event := range replay {
+		if err := writeSSE(c.Writer, event); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeSSE(c.Writer, event); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeSSE écrit un événement au format text/event-stream
+func writeSSE(w gin.ResponseWriter, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
+
+// ServeWebSocket diffuse les événements en JSON sur une WebSocket (GET /events/ws) ;
+// une origine refusée par upgrader reçoit 403
+func (h *EventHub) ServeWebSocket(upgrader *websocket.Upgrader) gin.HandlerFunc {
+	return func(c *gin.Context) { h.serveWebSocket(c, upgrader) }
+}
+
+func (h *EventHub) serveWebSocket(c *gin.Context, upgrader *websocket.Upgrader) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	replay, events, cancel := h.subscribeFromRequest(c)
+	defer cancel()
+
+	// Lecture en arrière-plan pour détecter la fermeture par le client
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for _, event := range replay {
+		if err := conn.WriteJSON(event); err != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case event, ok := <-events:
+			if !ok {
+				conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "reprendre depuis le dernier ID reçu"))
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(5*time.Second)); err != nil {
+				return
+			}
+		}
+	}
+}
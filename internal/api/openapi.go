@@ -0,0 +1,343 @@
+package api
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed docs.html
+var docsPage []byte
+
+// apiParam paramètre de chemin ou de requête d'une opération
+type apiParam struct {
+	Name        string
+	In          string // "path" ou "query"
+	Type        string // type OpenAPI (string, integer)
+	Description string
+}
+
+// apiOperation décrit une route de l'API pour la spécification OpenAPI
+type apiOperation struct {
+	Method      string
+	Path        string // au format OpenAPI (/players/{id}), sans le préfixe /v1
+	Tag         string
+	Summary     string
+	Auth        string // "", "player" ou "admin"
+	Params      []apiParam
+	Request     any        // valeur du type du corps (nil : pas de corps)
+	Status      int        // statut de succès
+	Response    any        // valeur du type de la réponse (nil : pas de corps JSON)
+	ContentType string     // type de la réponse si ce n'est pas du JSON
+	Headers     []apiParam // en-têtes de la réponse de succès
+	Errors      []int
+}
+
+// apiOperations liste toutes les routes de registerRoutes
+func apiOperations() []apiOperation {
+	playerID := apiParam{Name: "id", In: "path", Type: "string", Description: "ID du joueur"}
+	webhookID := apiParam{Name: "id", In: "path", Type: "string", Description: "ID de l'abonnement"}
+
+	return []apiOperation{
+		{Method: http.MethodGet, Path: "/status", Tag: "jeu", Summary: "État du serveur et WordMon actuel",
+			Status: http.StatusOK, Response: StatusResponse{}, Errors: []int{500}},
+
+		{Method: http.MethodPost, Path: "/players", Tag: "joueurs", Summary: "Inscrit un joueur ; le jeton n'est retourné qu'ici",
+			Request: CreatePlayerRequest{}, Status: http.StatusCreated, Response: PlayerJSON{}, Errors: []int{400, 409, 503}},
+		{Method: http.MethodGet, Path: "/players/{id}", Tag: "joueurs", Summary: "Joueur et inventaire",
+			Params: []apiParam{playerID}, Status: http.StatusOK, Response: PlayerJSON{}, Errors: []int{404}},
+		{Method: http.MethodGet, Path: "/players/{id}/rank", Tag: "joueurs", Summary: "Rang, percentile et voisins du joueur",
+			Params: append([]apiParam{playerID}, append(rankingParams(),
+				apiParam{Name: "neighbors", In: "query", Type: "integer", Description: "Voisins de part et d'autre (0-10, défaut 1)"})...),
+			Status: http.StatusOK, Response: PlayerRankJSON{}, Errors: []int{400, 404}},
+
+		{Method: http.MethodGet, Path: "/spawn/current", Tag: "jeu", Summary: "WordMon actuellement en jeu",
+			Status: http.StatusOK, Response: SpawnJSON{}, Errors: []int{404}},
+		{Method: http.MethodPost, Path: "/encounter/attempt", Tag: "jeu", Summary: "Tentative de capture (anagramme du mot)",
+			Auth: "player", Request: AttemptRequest{}, Status: http.StatusOK, Response: AttemptResponse{},
+			Errors: []int{400, 401, 403, 404, 409, 429}},
+
+		{Method: http.MethodPost, Path: "/auth/token/rotate", Tag: "jetons", Summary: "Nouveau jeton ; l'actuel est révoqué",
+			Auth: "player", Status: http.StatusOK, Response: TokenResponse{}, Errors: []int{401}},
+		{Method: http.MethodDelete, Path: "/auth/token", Tag: "jetons", Summary: "Révoque le jeton courant",
+			Auth: "player", Status: http.StatusNoContent, Errors: []int{401}},
+		{Method: http.MethodDelete, Path: "/auth/tokens", Tag: "jetons", Summary: "Révoque tous les jetons du joueur",
+			Auth: "player", Status: http.StatusNoContent, Errors: []int{401}},
+
+		{Method: http.MethodGet, Path: "/leaderboard", Tag: "jeu", Summary: "Classement paginé (rangs denses)",
+			Params: append(rankingParams(),
+				apiParam{Name: "limit", In: "query", Type: "integer", Description: "Nombre de joueurs (1-50, défaut 10)"},
+				apiParam{Name: "cursor", In: "query", Type: "string", Description: "Page suivante (en-tête X-Next-Cursor)"}),
+			Status: http.StatusOK, Response: []LeaderboardEntryJSON{}, Errors: []int{400, 500},
+			Headers: []apiParam{
+				{Name: totalCountHeader, Type: "integer", Description: "Joueurs retenus par les filtres"},
+				{Name: nextCursorHeader, Type: "string", Description: "Curseur de la page suivante (absent sur la dernière)"},
+			}},
+
+		{Method: http.MethodGet, Path: "/events/stream", Tag: "événements", Summary: "Flux d'événements (Server-Sent Events)",
+			Params: eventStreamParams(), Status: http.StatusOK, ContentType: "text/event-stream"},
+		{Method: http.MethodGet, Path: "/events/ws", Tag: "événements", Summary: "Flux d'événements (WebSocket)",
+			Params: eventStreamParams(), Status: http.StatusSwitchingProtocols},
+		{Method: http.MethodGet, Path: "/events/metrics", Tag: "événements", Summary: "Compteurs des événements publiés",
+			Status: http.StatusOK, Response: map[string]int64{}},
+
+		{Method: http.MethodPost, Path: "/admin/webhooks", Tag: "webhooks", Summary: "Crée un abonnement ; le secret n'est retourné qu'ici",
+			Auth: "admin", Request: CreateWebhookRequest{}, Status: http.StatusCreated, Response: webhook.Subscription{},
+			Errors: []int{400, 401, 503}},
+		{Method: http.MethodGet, Path: "/admin/webhooks", Tag: "webhooks", Summary: "Liste les abonnements",
+			Auth: "admin", Status: http.StatusOK, Response: []webhook.Subscription{}, Errors: []int{401, 503}},
+		{Method: http.MethodGet, Path: "/admin/webhooks/{id}", Tag: "webhooks", Summary: "Détail d'un abonnement",
+			Auth: "admin", Params: []apiParam{webhookID}, Status: http.StatusOK, Response: webhook.Subscription{},
+			Errors: []int{401, 404, 503}},
+		{Method: http.MethodDelete, Path: "/admin/webhooks/{id}", Tag: "webhooks", Summary: "Supprime un abonnement",
+			Auth: "admin", Params: []apiParam{webhookID}, Status: http.StatusNoContent, Errors: []int{401, 404, 503}},
+		{Method: http.MethodGet, Path: "/admin/webhooks/{id}/deliveries", Tag: "webhooks", Summary: "Journal des livraisons",
+			Auth: "admin", Params: []apiParam{webhookID,
+				{Name: "status", In: "query", Type: "string", Description: "pending, delivered ou dead"},
+				{Name: "limit", In: "query", Type: "integer", Description: "Nombre de livraisons (défaut 50, max 500)"}},
+			Status: http.StatusOK, Response: []webhook.Delivery{}, Errors: []int{401, 404, 503}},
+
+		{Method: http.MethodGet, Path: "/openapi.json", Tag: "documentation", Summary: "Cette spécification",
+			Status: http.StatusOK, Response: map[string]any{}},
+		{Method: http.MethodGet, Path: "/docs", Tag: "documentation", Summary: "Documentation interactive",
+			Status: http.StatusOK, ContentType: "text/html"},
+	}
+}
+
+// rankingParams critère et filtres communs aux classements
+func rankingParams() []apiParam {
+	return []apiParam{
+		{Name: "by", In: "query", Type: "string", Description: "Critère : xp (défaut), captures, legendary ou distinct"},
+		{Name: "team", In: "query", Type: "string", Description: "Joueurs de cette équipe uniquement"},
+		{Name: "minLevel", In: "query", Type: "integer", Description: "Niveau minimum"},
+		{Name: "maxLevel", In: "query", Type: "integer", Description: "Niveau maximum"},
+	}
+}
+
+// eventStreamParams paramètres communs des flux d'événements
+func eventStreamParams() []apiParam {
+	return []apiParam{
+		{Name: "player", In: "query", Type: "string", Description: "Ne recevoir que les événements de ce joueur"},
+		{Name: "types", In: "query", Type: "string", Description: "Types d'événements, séparés par des virgules"},
+		{Name: "lastEventId", In: "query", Type: "integer", Description: "Reprise après cet ID (ou en-tête Last-Event-ID) ; un événement reset signale une reprise impossible"},
+	}
+}
+
+// OpenAPISpec construit la spécification OpenAPI 3 de l'API ; les schémas
+// sont dérivés des structures JSON du package
+func OpenAPISpec(version string) map[string]any {
+	schemas := map[string]any{}
+	paths := map[string]map[string]any{}
+
+	for _, op := range apiOperations() {
+		operation := map[string]any{
+			"tags":        []string{op.Tag},
+			"summary":     op.Summary,
+			"operationId": operationID(op),
+		}
+
+		if len(op.Params) > 0 {
+			params := make([]map[string]any, len(op.Params))
+			for i, p := range op.Params {
+				params[i] = map[string]any{
+					"name":        p.Name,
+					"in":          p.In,
+					"required":    p.In == "path",
+					"description": p.Description,
+					"schema":      map[string]any{"type": p.Type},
+				}
+			}
+			operation["parameters"] = params
+		}
+
+		switch op.Auth {
+		case "player":
+			operation["security"] = []map[string][]string{{"playerToken": {}}}
+		case "admin":
+			operation["security"] = []map[string][]string{{"adminToken": {}}}
+		}
+
+		if op.Request != nil {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(schemaOf(reflect.TypeOf(op.Request), schemas)),
+			}
+		}
+
+		success := map[string]any{"description": http.StatusText(op.Status)}
+		switch {
+		case op.Response != nil:
+			success["content"] = jsonContent(schemaOf(reflect.TypeOf(op.Response), schemas))
+		case op.ContentType != "":
+			success["content"] = map[string]any{op.ContentType: map[string]any{"schema": map[string]any{"type": "string"}}}
+		}
+		if len(op.Headers) > 0 {
+			headers := map[string]any{}
+			for _, h := range op.Headers {
+				headers[h.Name] = map[string]any{"description": h.Description, "schema": map[string]any{"type": h.Type}}
+			}
+			success["headers"] = headers
+		}
+		responses := map[string]any{strconv.Itoa(op.Status): success}
+		for _, status := range op.Errors {
+			responses[strconv.Itoa(status)] = map[string]any{"$ref": "#/components/responses/Error"}
+		}
+		operation["responses"] = responses
+
+		if paths[op.Path] == nil {
+			paths[op.Path] = map[string]any{}
+		}
+		paths[op.Path][strings.ToLower(op.Method)] = operation
+	}
+
+	errorSchema := schemaOf(reflect.TypeOf(ErrorEnvelope{}), schemas)
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "WordMon Go API",
+			"version": version,
+			"description": "Capture de WordMon par anagrammes. Les routes sans préfixe /v1 restent " +
+				"disponibles pendant la période de dépréciation ; leurs erreurs ont la forme {\"error\": message}.",
+		},
+		"servers": []map[string]any{{"url": "/v1"}},
+		"paths":   paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"responses": map[string]any{
+				"Error": map[string]any{
+					"description": "Erreur (enveloppe stable, voir error.code)",
+					"content":     jsonContent(errorSchema),
+				},
+			},
+			"securitySchemes": map[string]any{
+				"playerToken": map[string]any{"type": "http", "scheme": "bearer", "description": "Jeton du joueur"},
+				"adminToken":  map[string]any{"type": "http", "scheme": "bearer", "description": "WORDMON_ADMIN_TOKEN"},
+			},
+		},
+	}
+}
+
+// registerDocsRoutes sert la spécification OpenAPI et la page de documentation
+func registerDocsRoutes(router gin.IRoutes, spec []byte) {
+	router.GET("/openapi.json", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", spec)
+	})
+	router.GET("/docs", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", docsPage)
+	})
+}
+
+// marshalOpenAPISpec sérialise la spécification une fois pour toutes
+func marshalOpenAPISpec(version string) []byte {
+	spec, err := json.MarshalIndent(OpenAPISpec(version), "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return spec
+}
+
+// operationID dérive un identifiant d'opération de la méthode et du chemin
+func operationID(op apiOperation) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(op.Method))
+	for _, part := range strings.FieldsFunc(op.Path, func(r rune) bool { return r == '/' || r == '.' || r == '_' }) {
+		part = strings.Trim(part, "{}")
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// jsonContent retourne le contenu application/json d'un schéma
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaOf retourne le schéma JSON d'un type Go ; les structures nommées sont
+// ajoutées aux composants et référencées par $ref
+func schemaOf(t reflect.Type, schemas map[string]any) map[string]any {
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t.Kind() == reflect.Pointer:
+		schema := schemaOf(t.Elem(), schemas)
+		if _, isRef := schema["$ref"]; isRef {
+			// OpenAPI 3.0 ignore les propriétés voisines d'un $ref
+			return map[string]any{"allOf": []any{schema}, "nullable": true}
+		}
+		schema["nullable"] = true
+		return schema
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": schemaOf(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaOf(t.Elem(), schemas)}
+	case reflect.Struct:
+		name := t.Name()
+		if _, done := schemas[name]; !done {
+			schemas[name] = map[string]any{} // réservé (types récursifs)
+			schemas[name] = structSchema(t, schemas)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	}
+	return map[string]any{} // any : valeur libre
+}
+
+// structSchema retourne le schéma objet d'une structure (champs embarqués à plat)
+func structSchema(t reflect.Type, schemas map[string]any) map[string]any {
+	properties := map[string]any{}
+	var required []string
+
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+				collect(field.Type)
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+
+			properties[name] = schemaOf(field.Type, schemas)
+			if !strings.Contains(opts, "omitempty") {
+				required = append(required, name)
+			}
+		}
+	}
+	collect(t)
+
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// ginParam convertit les paramètres Gin (:id) au format OpenAPI ({id})
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+func TestOpenAPISpecCoversEveryRoute(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	w := doJSON(server, http.MethodGet, "/v1/openapi.json", "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("openapi.json: attendu 200, obtenu %d", w.Code)
+	}
+	var spec struct {
+		Paths map[string]map[string]any `json:"paths"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("spécification invalide: %v", err)
+	}
+
+	// Routes /v1 et historiques : mêmes chemins une fois le préfixe retiré
+	for _, route := range server.router.Routes() {
+		path := ginParam.ReplaceAllString(strings.TrimPrefix(route.Path, "/v1"), "{$1}")
+		if _, ok := spec.Paths[path][strings.ToLower(route.Method)]; !ok {
+			t.Errorf("route absente de la spécification: %s %s", route.Method, route.Path)
+		}
+	}
+}
+
+func TestOpenAPISchemasFollowJSONTags(t *testing.T) {
+	spec := OpenAPISpec("test")
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+
+	attempt := schemas["AttemptRequest"].(map[string]any)
+	if required := attempt["required"].([]string); len(required) != 1 || required[0] != "attempt" {
+		t.Errorf("AttemptRequest: seul attempt est requis, obtenu %v", required)
+	}
+
+	// SpawnJSON embarque WordJSON : ses champs sont à plat
+	spawn := schemas["SpawnJSON"].(map[string]any)["properties"].(map[string]any)
+	for _, field := range []string{"spawnId", "id", "text", "rarity", "points"} {
+		if _, ok := spawn[field]; !ok {
+			t.Errorf("SpawnJSON: champ %s absent", field)
+		}
+	}
+}
+
+func TestDocsPageIsSelfContained(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	w := doJSON(server, http.MethodGet, "/docs", "", "")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "openapi.json") {
+		t.Fatalf("page de documentation attendue: %d", w.Code)
+	}
+	if strings.Contains(w.Body.String(), "<script src=") || strings.Contains(w.Body.String(), "https://") {
+		t.Error("la documentation ne doit charger aucune ressource externe")
+	}
+}
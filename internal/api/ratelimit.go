@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/ratelimit"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitFromConfig convertit la section rateLimit de la config
+func RateLimitFromConfig(gameConfig *config.GameConfig) ratelimit.Config {
+	rl := gameConfig.RateLimit
+	return ratelimit.Config{
+		Player:              ratelimit.PerMinute(rl.PlayerPerMinute, rl.PlayerBurst),
+		IP:                  ratelimit.PerMinute(rl.IPPerMinute, rl.IPBurst),
+		MaxAttemptsPerSpawn: rl.MaxAttemptsPerSpawn,
+		SpawnTTL:            time.Duration(gameConfig.Spawner.AutoFleeAfterSeconds) * time.Second,
+		WrongAnswerCooldown: time.Duration(rl.WrongAnswerCooldownMs) * time.Millisecond,
+	}
+}
+
+// NewRateLimiter crée le limiteur des tentatives selon rateLimit.backend :
+// "postgres" partage l'état entre instances via db, sinon l'état reste en mémoire
+func NewRateLimiter(gameConfig *config.GameConfig, db *sql.DB) *ratelimit.Limiter {
+	cfg := RateLimitFromConfig(gameConfig)
+	if gameConfig.RateLimit.Backend == "postgres" {
+		if db != nil {
+			log.Printf("[ratelimit] Backend postgres: %s", cfg)
+			return ratelimit.NewLimiter(ratelimit.NewPostgresStore(db), cfg)
+		}
+		log.Printf("[ratelimit] Backend postgres indisponible, repli en mémoire")
+	}
+	log.Printf("[ratelimit] Backend mémoire: %s", cfg)
+	return ratelimit.NewLimiter(ratelimit.NewMemoryStore(), cfg)
+}
+
+// attemptGuard protège POST /encounter/attempt contre la force brute
+type attemptGuard struct {
+	limiter *ratelimit.Limiter
+	xpCost  int
+}
+
+// newAttemptGuard crée la protection des tentatives avec un état en mémoire
+// (remplaçable par SetRateLimiter pour un déploiement multi-instances)
+func newAttemptGuard(gameConfig *config.GameConfig) *attemptGuard {
+	return &attemptGuard{
+		limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore(), RateLimitFromConfig(gameConfig)),
+		xpCost:  gameConfig.RateLimit.WrongAnswerXPCost,
+	}
+}
+
+// limitIP limite les requêtes par adresse IP (avant l'authentification)
+func (g *attemptGuard) limitIP(c *gin.Context) {
+	if !throttle(c, g.limiter.AllowIP(c.Request.Context(), c.ClientIP())) {
+		return
+	}
+	c.Next()
+}
+
+// limitPlayer limite les tentatives du joueur authentifié et applique son refroidissement
+func (g *attemptGuard) limitPlayer(c *gin.Context) {
+	if !throttle(c, g.limiter.AllowPlayer(c.Request.Context(), authenticatedToken(c).PlayerID)) {
+		return
+	}
+	c.Next()
+}
+
+// allowSpawnAttempt applique le plafond de tentatives du joueur sur le spawn
+func (g *attemptGuard) allowSpawnAttempt(c *gin.Context, spawnID, playerID string) bool {
+	return throttle(c, g.limiter.AllowSpawnAttempt(c.Request.Context(), spawnID, playerID))
+}
+
+// wrongAnswer applique le refroidissement d'une mauvaise réponse et retourne
+// le coût en XP à prélever (0 si désactivé)
+func (g *attemptGuard) wrongAnswer(ctx context.Context, playerID string) int {
+	g.limiter.PenalizeWrongAnswer(ctx, playerID)
+	return g.xpCost
+}
+
+// throttle répond 429 avec Retry-After si la décision est défavorable
+func throttle(c *gin.Context, decision ratelimit.Decision) bool {
+	if decision.Allowed {
+		return true
+	}
+
+	seconds := ratelimit.RetryAfterSeconds(decision.RetryAfter)
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	abortWithError(c, &APIError{
+		Status:  http.StatusTooManyRequests,
+		Code:    CodeRateLimited,
+		Message: decision.Reason,
+		Details: gin.H{"retryAfterSeconds": seconds},
+	})
+	return false
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/ratelimit"
+)
+
+func TestAttemptThrottledWithRetryAfter(t *testing.T) {
+	server, _ := newTestServer(t)
+	server.SetRateLimiter(ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Config{
+		Player: ratelimit.PerMinute(1, 1),
+	}))
+	alice := createTestPlayer(t, server, "Alice")
+
+	// La première tentative (mauvaise) consomme l'unique jeton
+	doJSON(server, http.MethodPost, "/encounter/attempt", alice.Token, `{"attempt":"xxxx"}`)
+
+	w := doJSON(server, http.MethodPost, "/encounter/attempt", alice.Token, `{"attempt":"tach"}`)
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("attendu 429, obtenu %d %s", w.Code, w.Body.String())
+	}
+	if w.Header().Get("Retry-After") != "60" {
+		t.Errorf("Retry-After attendu 60, obtenu %q", w.Header().Get("Retry-After"))
+	}
+}
@@ -1,26 +1,44 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/SamG1008/wordmon-go/internal/auth"
 	"github.com/SamG1008/wordmon-go/internal/config"
 	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/game"
+	"github.com/SamG1008/wordmon-go/internal/ratelimit"
 	"github.com/SamG1008/wordmon-go/internal/store"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
 	"github.com/gin-gonic/gin"
 )
 
-// Server représente le serveur API
+// Server serveur API unique, quel que soit le backend de stockage.
+// Les règles du jeu sont appliquées par game.GameService.
 type Server struct {
-	store      *store.MemoryStore
+	store      store.Store
+	game       *game.GameService
 	gameConfig *config.GameConfig
 	router     *gin.Engine
+	startTime  time.Time
+	events     *EventHub
+	metrics    *core.EventMetrics
+	issuer     *auth.Issuer
+	guard      *attemptGuard
+	spec       []byte // spécification OpenAPI servie par /openapi.json
 }
 
-// NewServer crée un nouveau serveur API
-func NewServer(store *store.MemoryStore, gameConfig *config.GameConfig) *Server {
+// NewServer crée le serveur API sur le store fourni et publie ses événements sur bus
+func NewServer(s store.Store, gameConfig *config.GameConfig, bus *core.EventBus) (*Server, error) {
+	issuer, err := newIssuer(gameConfig, s)
+	if err != nil {
+		return nil, fmt.Errorf("erreur secret des jetons: %w", err)
+	}
+
 	// Configuration de Gin
 	gin.SetMode(gin.ReleaseMode) // Moins verbeux pour la production
 	router := gin.New()
@@ -29,50 +47,107 @@ func NewServer(store *store.MemoryStore, gameConfig *config.GameConfig) *Server
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
 
+	bus, events, metrics := attachEventBus(bus)
+
 	server := &Server{
-		store:      store,
+		store:      s,
+		game:       game.NewGameService(s, gameConfig, bus),
 		gameConfig: gameConfig,
 		router:     router,
+		startTime:  time.Now(),
+		events:     events,
+		metrics:    metrics,
+		issuer:     issuer,
+		guard:      newAttemptGuard(gameConfig),
+		spec:       marshalOpenAPISpec(gameConfig.Game.Version),
 	}
 
 	// Configurer les routes
 	server.setupRoutes()
 
-	return server
+	return server, nil
 }
 
-// setupRoutes configure toutes les routes de l'API
+// setupRoutes configure les routes /v1 et, pendant la période de dépréciation,
+// les mêmes routes sans préfixe
 func (s *Server) setupRoutes() {
+	s.router.Use(requestID)
+
+	s.registerRoutes(s.router.Group("/v1", useErrorEnvelope))
+	s.registerRoutes(s.router.Group("", deprecatedRoute))
+}
+
+// registerRoutes ajoute toutes les routes de l'API au groupe
+func (s *Server) registerRoutes(router *gin.RouterGroup) {
 	// Routes principales
-	s.router.GET("/status", s.getStatus)
+	router.GET("/status", s.getStatus)
 
 	// Routes des joueurs
-	s.router.POST("/players", s.createPlayer)
-	s.router.GET("/players/:id", s.getPlayer)
+	router.POST("/players", s.createPlayer)
+	router.GET("/players/:id", s.getPlayer)
+	router.GET("/players/:id/rank", s.getPlayerRank)
 
 	// Routes du spawn
-	s.router.GET("/spawn/current", s.getCurrentSpawn)
+	router.GET("/spawn/current", s.getCurrentSpawn)
 
 	// Routes des tentatives
-	s.router.POST("/encounter/attempt", s.attemptCapture)
+	router.POST("/encounter/attempt", s.guard.limitIP, requirePlayer(s.issuer), s.guard.limitPlayer, s.attemptCapture)
+
+	// Routes des jetons du joueur
+	registerAuthRoutes(router, s.issuer)
 
 	// Routes du leaderboard
-	s.router.GET("/leaderboard", s.getLeaderboard)
+	router.GET("/leaderboard", s.getLeaderboard)
+
+	// Flux d'événements (SSE et WebSocket)
+	registerEventRoutes(router, s.events, s.metrics, newUpgrader(s.gameConfig.Server.AllowedOrigins))
+
+	// Administration des webhooks (si le store les persiste)
+	if webhooks, ok := s.store.(webhook.Store); ok {
+		registerWebhookRoutes(router, webhooks, s.gameConfig.Admin.Token)
+	}
+
+	// Spécification OpenAPI et documentation
+	registerDocsRoutes(router, s.spec)
+}
+
+// Game retourne le service de jeu du serveur
+func (s *Server) Game() *game.GameService {
+	return s.game
+}
+
+// SetRateLimiter remplace le limiteur des tentatives (à appeler avant de servir)
+func (s *Server) SetRateLimiter(limiter *ratelimit.Limiter) {
+	s.guard.limiter = limiter
+}
+
+// StartSpawner démarre l'apparition des WordMon jusqu'à l'annulation du contexte
+func (s *Server) StartSpawner(ctx context.Context) {
+	go s.game.Start(ctx)
+}
+
+// Handler retourne le routeur HTTP du serveur
+func (s *Server) Handler() http.Handler {
+	return s.router
 }
 
-// Run démarre le serveur sur le port spécifié
-func (s *Server) Run(port string) error {
-	return s.router.Run(":" + port)
+// HTTPServer retourne le serveur HTTP de l'API sur port. Son Shutdown clôt
+// aussi les flux d'événements, qui le retiendraient sinon jusqu'à l'échéance.
+func (s *Server) HTTPServer(port string) *http.Server {
+	srv := &http.Server{Addr: ":" + port, Handler: s.router}
+	srv.RegisterOnShutdown(s.events.Close)
+	return srv
 }
 
 // Structures pour les réponses JSON
 
 type StatusResponse struct {
-	Game          string    `json:"game"`
-	Version       string    `json:"version"`
-	UptimeSeconds int       `json:"uptimeSeconds"`
-	ActivePlayers int       `json:"activePlayers"`
-	CurrentSpawn  *WordJSON `json:"currentSpawn"`
+	Game          string     `json:"game"`
+	Version       string     `json:"version"`
+	Store         string     `json:"store"`
+	UptimeSeconds int        `json:"uptimeSeconds"`
+	ActivePlayers int        `json:"activePlayers"`
+	CurrentSpawn  *SpawnJSON `json:"currentSpawn"`
 }
 
 type PlayerJSON struct {
@@ -80,7 +155,27 @@ type PlayerJSON struct {
 	Name      string         `json:"name"`
 	XP        int            `json:"xp"`
 	Level     int            `json:"level"`
+	Team      string         `json:"team,omitempty"`
 	Inventory map[string]int `json:"inventory,omitempty"`
+	Token     string         `json:"token,omitempty"` // retourné uniquement à la création
+}
+
+// LeaderboardEntryJSON joueur classé : rang dense (ex aequo au même rang)
+// et score du critère de classement
+type LeaderboardEntryJSON struct {
+	PlayerJSON
+	Rank  int `json:"rank"`
+	Score int `json:"score"`
+}
+
+// PlayerRankJSON rang d'un joueur et ses voisins directs
+type PlayerRankJSON struct {
+	LeaderboardEntryJSON
+	By         string                 `json:"by"`
+	Total      int                    `json:"total"`
+	Percentile float64                `json:"percentile"` // joueurs au score inférieur ou égal, en %
+	Above      []LeaderboardEntryJSON `json:"above"`
+	Below      []LeaderboardEntryJSON `json:"below"`
 }
 
 type WordJSON struct {
@@ -90,45 +185,59 @@ type WordJSON struct {
 	Points int    `json:"points"`
 }
 
+// SpawnJSON WordMon en jeu ; spawnId identifie son combat
+type SpawnJSON struct {
+	SpawnID string `json:"spawnId"`
+	WordJSON
+}
+
 type CreatePlayerRequest struct {
 	Name string `json:"name" binding:"required"`
+	Team string `json:"team,omitempty"`
 }
 
+// AttemptRequest corps d'une tentative ; le joueur est celui du jeton,
+// playerId reste accepté s'il correspond. spawnId (optionnel) cible un
+// combat précis : la tentative est refusée s'il est déjà clos.
 type AttemptRequest struct {
-	PlayerID string `json:"playerId" binding:"required"`
+	PlayerID string `json:"playerId,omitempty"`
+	SpawnID  string `json:"spawnId,omitempty"`
 	Attempt  string `json:"attempt" binding:"required"`
 }
 
 type AttemptResponse struct {
-	Status   string `json:"status"`
-	Word     string `json:"word,omitempty"`
-	Rarity   string `json:"rarity,omitempty"`
-	XPGained int    `json:"xpGained,omitempty"`
-	NewLevel int    `json:"newLevel,omitempty"`
-	Reason   string `json:"reason,omitempty"`
+	Status    string `json:"status"`
+	SpawnID   string `json:"spawnId,omitempty"`
+	Word      string `json:"word,omitempty"`
+	Rarity    string `json:"rarity,omitempty"`
+	XPGained  int    `json:"xpGained,omitempty"`
+	NewLevel  int    `json:"newLevel,omitempty"`
+	XPPenalty int    `json:"xpPenalty,omitempty"` // XP retirée pour une mauvaise réponse
+	Reason    string `json:"reason,omitempty"`
 }
 
 // Handlers
 
 // getStatus retourne le status du serveur
 func (s *Server) getStatus(c *gin.Context) {
-	currentSpawn := s.store.GetCurrentSpawn()
-	var currentSpawnJSON *WordJSON = nil
-
-	if currentSpawn != nil {
-		currentSpawnJSON = &WordJSON{
-			ID:     currentSpawn.ID,
-			Text:   currentSpawn.Text,
-			Rarity: string(currentSpawn.Rarity),
-			Points: currentSpawn.Points,
-		}
+	players, err := s.game.Leaderboard(c.Request.Context(), 0)
+	if err != nil {
+		abortWithCode(c, http.StatusInternalServerError, CodeInternal, "erreur récupération stats")
+		return
+	}
+
+	var currentSpawnJSON *SpawnJSON
+	if currentSpawn := s.game.CurrentSpawn(); currentSpawn != nil {
+		spawn := CoreSpawnToJSON(currentSpawn)
+		currentSpawnJSON = &spawn
 	}
 
 	response := StatusResponse{
 		Game:          s.gameConfig.Game.Name,
 		Version:       s.gameConfig.Game.Version,
-		UptimeSeconds: s.store.GetUptimeSeconds(),
-		ActivePlayers: s.store.GetActivePlayersCount(),
+		Store:         s.gameConfig.Server.Store,
+		UptimeSeconds: int(time.Since(s.startTime).Seconds()),
+		ActivePlayers: len(players),
 		CurrentSpawn:  currentSpawnJSON,
 	}
 
@@ -139,171 +248,241 @@ func (s *Server) getStatus(c *gin.Context) {
 func (s *Server) createPlayer(c *gin.Context) {
 	var req CreatePlayerRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "nom requis"})
+		abortWithCode(c, http.StatusBadRequest, CodeInvalidRequest, "nom requis")
 		return
 	}
 
-	// Valider le nom
-	name := strings.TrimSpace(req.Name)
-	if name == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "nom ne peut pas être vide"})
+	// Le nom est unique sur tous les backends
+	player, err := s.game.CreatePlayerInTeam(c.Request.Context(), req.Name, req.Team)
+	if err != nil {
+		abortWithError(c, fmt.Errorf("erreur création: %w", err))
 		return
 	}
 
-	player, err := s.store.CreatePlayer(name)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	token, ok := issuePlayerToken(c, s.issuer, player.ID)
+	if !ok {
+		// Sans jeton, le joueur serait inutilisable et son nom bloqué
+		if err := s.game.DeletePlayer(context.WithoutCancel(c.Request.Context()), player.ID); err != nil {
+			fmt.Printf("[api] Erreur suppression du joueur %s sans jeton: %v\n", player.ID, err)
+		}
 		return
 	}
 
-	response := PlayerJSON{
-		ID:    player.ID,
-		Name:  player.Name,
-		XP:    player.XP,
-		Level: player.Level,
-	}
+	// Le jeton n'est retourné qu'à la création
+	response := CorePlayerToJSON(player)
+	response.Inventory = nil
+	response.Token = token
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusCreated, response)
 }
 
 // getPlayer récupère un joueur par ID
 func (s *Server) getPlayer(c *gin.Context) {
-	id := c.Param("id")
-
-	player, err := s.store.GetPlayer(id)
+	player, err := s.game.Player(c.Request.Context(), c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "joueur non trouvé"})
+		abortWithError(c, err)
 		return
 	}
 
-	response := PlayerJSON{
-		ID:        player.ID,
-		Name:      player.Name,
-		XP:        player.XP,
-		Level:     player.Level,
-		Inventory: player.Inventory,
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, CorePlayerToJSON(player))
 }
 
 // getCurrentSpawn retourne le WordMon actuel
 func (s *Server) getCurrentSpawn(c *gin.Context) {
-	currentSpawn := s.store.GetCurrentSpawn()
+	currentSpawn := s.game.CurrentSpawn()
 	if currentSpawn == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "aucun WordMon actif"})
+		abortWithError(c, game.ErrNoSpawn)
 		return
 	}
 
-	response := WordJSON{
-		ID:     currentSpawn.ID,
-		Text:   currentSpawn.Text,
-		Rarity: string(currentSpawn.Rarity),
-		Points: currentSpawn.Points,
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, CoreSpawnToJSON(currentSpawn))
 }
 
 // attemptCapture gère les tentatives de capture
 func (s *Server) attemptCapture(c *gin.Context) {
 	var req AttemptRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "données requises manquantes"})
+		abortWithCode(c, http.StatusBadRequest, CodeInvalidRequest, "données requises manquantes")
 		return
 	}
 
-	// Vérifier que le joueur existe
-	player, err := s.store.GetPlayer(req.PlayerID)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "joueur non trouvé"})
+	// Le joueur est celui du jeton
+	playerID, ok := attemptPlayerID(c, req.PlayerID)
+	if !ok {
 		return
 	}
 
-	// Vérifier qu'il y a un spawn actif
-	currentSpawn := s.store.GetCurrentSpawn()
-	if currentSpawn == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "aucun WordMon actif"})
-		return
+	// Combat visé : celui demandé, sinon le WordMon actuel
+	spawnID := req.SpawnID
+	if spawnID == "" {
+		currentSpawn := s.game.CurrentSpawn()
+		if currentSpawn == nil {
+			abortWithError(c, game.ErrNoSpawn)
+			return
+		}
+		spawnID = currentSpawn.BattleID
 	}
 
-	// Créer un challenge pour tester la réponse
-	challenge := core.NewAnagramChallenge(*currentSpawn)
-	isCorrect, err := challenge.Check(req.Attempt)
+	// Plafond de tentatives du joueur sur ce WordMon
+	if !s.guard.allowSpawnAttempt(c, spawnID, playerID) {
+		return
+	}
 
+	result, err := s.game.Attempt(c.Request.Context(), playerID, spawnID, req.Attempt)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "erreur lors de la vérification"})
+		abortWithError(c, err)
 		return
 	}
 
+	// Pénalité d'une mauvaise réponse
+	penalty := 0
+	if !result.Correct {
+		penalty = s.chargeWrongAnswer(c.Request.Context(), playerID)
+	}
+
+	response := attemptResponse(result)
+	response.XPPenalty = penalty
+	c.JSON(http.StatusOK, response)
+}
+
+// attemptResponse construit la réponse JSON d'une tentative arbitrée
+func attemptResponse(result game.AttemptResult) AttemptResponse {
 	response := AttemptResponse{
-		Word:   currentSpawn.Text,
-		Rarity: string(currentSpawn.Rarity),
-	}
-
-	if isCorrect {
-		// Victoire - attribuer les XP
-		oldLevel := player.Level
-		player.AwardXP(currentSpawn.Points)
-		player.Inventory[currentSpawn.ID]++
-
-		// Sauvegarder le joueur
-		s.store.UpdatePlayer(player)
-
-		// Supprimer le spawn (capturé)
-		s.store.ClearCurrentSpawn()
-
-		response.Status = "captured"
-		response.XPGained = currentSpawn.Points
-		response.NewLevel = player.Level
-
-		// Log de capture
-		if player.Level > oldLevel {
-			gin.DefaultWriter.Write([]byte(
-				fmt.Sprintf("[player] %s a capturé \"%s\" (XP+%d, Level=%d)\n",
-					player.Name, currentSpawn.Text, currentSpawn.Points, player.Level)))
-		} else {
-			gin.DefaultWriter.Write([]byte(
-				fmt.Sprintf("[player] %s a capturé \"%s\" (XP+%d)\n",
-					player.Name, currentSpawn.Text, currentSpawn.Points)))
-		}
-	} else {
-		// Défaite
-		response.Status = "fled"
-		response.Reason = "wrong attempt"
+		Status:  string(result.Status),
+		SpawnID: result.SpawnID,
+		Word:    result.Word.Text,
+		Rarity:  string(result.Word.Rarity),
+		Reason:  result.Reason,
+	}
 
-		// Le WordMon s'enfuit après une mauvaise tentative
-		s.store.ClearCurrentSpawn()
+	if result.Status == game.StatusCaptured {
+		response.XPGained = result.XPGained
+		response.NewLevel = result.Player.Level
 	}
 
-	c.JSON(http.StatusOK, response)
+	return response
+}
+
+// chargeWrongAnswer applique les pénalités d'une mauvaise réponse et retourne l'XP retirée
+func (s *Server) chargeWrongAnswer(ctx context.Context, playerID string) int {
+	cost := s.guard.wrongAnswer(ctx, playerID)
+
+	penalty, err := s.game.Penalize(ctx, playerID, cost)
+	if err != nil {
+		fmt.Printf("[api] %v\n", err)
+		return 0
+	}
+	return penalty
 }
 
+// En-têtes de pagination du classement
+const (
+	totalCountHeader = "X-Total-Count"
+	nextCursorHeader = "X-Next-Cursor"
+)
+
 // getLeaderboard retourne le classement des joueurs
 func (s *Server) getLeaderboard(c *gin.Context) {
-	// Récupérer le paramètre limit (défaut = 10, max = 50)
-	limitStr := c.DefaultQuery("limit", "10")
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit < 1 {
-		limit = 10
+	query, ok := leaderboardQuery(c)
+	if !ok {
+		return
 	}
-	if limit > 50 {
-		limit = 50
+	if cursor := c.Query("cursor"); cursor != "" {
+		after, err := store.ParseCursor(cursor)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+		query.After = after
 	}
+	// limit : défaut 10, max 50
+	query.Limit, _ = strconv.Atoi(c.Query("limit"))
 
-	players := s.store.GetLeaderboard(limit)
+	page, err := s.game.Ranking(c.Request.Context(), query)
+	if err != nil {
+		abortWithError(c, fmt.Errorf("erreur récupération leaderboard: %w", err))
+		return
+	}
 
-	response := make([]PlayerJSON, len(players))
-	for i, player := range players {
-		response[i] = PlayerJSON{
-			ID:    player.ID,
-			Name:  player.Name,
-			XP:    player.XP,
-			Level: player.Level,
+	// Pagination dans les en-têtes : le corps reste un tableau de joueurs
+	c.Header(totalCountHeader, strconv.Itoa(page.Total))
+	if page.Next != nil {
+		next := *c.Request.URL
+		values := next.Query()
+		values.Set("cursor", page.Next.String())
+		next.RawQuery = values.Encode()
+		c.Header(nextCursorHeader, page.Next.String())
+		c.Writer.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.RequestURI()))
+	}
+
+	c.JSON(http.StatusOK, rankedToJSON(page.Players))
+}
+
+// getPlayerRank retourne le rang, le percentile et les voisins d'un joueur
+func (s *Server) getPlayerRank(c *gin.Context) {
+	query, ok := leaderboardQuery(c)
+	if !ok {
+		return
+	}
+	neighbors := 1
+	if value := c.Query("neighbors"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			abortWithCode(c, http.StatusBadRequest, CodeInvalidRequest, "neighbors doit être un entier")
+			return
 		}
+		neighbors = n
 	}
 
-	c.JSON(http.StatusOK, response)
+	rank, err := s.game.PlayerRank(c.Request.Context(), c.Param("id"), query, neighbors)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	by, _ := store.ParseRankBy(string(query.By))
+	c.JSON(http.StatusOK, PlayerRankJSON{
+		LeaderboardEntryJSON: rankedToJSON([]store.RankedPlayer{rank.RankedPlayer})[0],
+		By:                   string(by),
+		Total:                rank.Total,
+		Percentile:           rank.Percentile,
+		Above:                rankedToJSON(rank.Above),
+		Below:                rankedToJSON(rank.Below),
+	})
+}
+
+// leaderboardQuery lit le critère (by) et les filtres (team, minLevel, maxLevel)
+// communs au classement et au rang d'un joueur
+func leaderboardQuery(c *gin.Context) (store.LeaderboardQuery, bool) {
+	by, err := store.ParseRankBy(c.Query("by"))
+	if err != nil {
+		abortWithError(c, err)
+		return store.LeaderboardQuery{}, false
+	}
+	query := store.LeaderboardQuery{By: by, Team: c.Query("team")}
+
+	levels := []struct {
+		name  string
+		level *int
+	}{{"minLevel", &query.MinLevel}, {"maxLevel", &query.MaxLevel}}
+	for _, bound := range levels {
+		value := c.Query(bound.name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			abortWithCode(c, http.StatusBadRequest, CodeInvalidRequest, bound.name+" doit être un entier")
+			return store.LeaderboardQuery{}, false
+		}
+		*bound.level = n
+	}
+
+	if err := query.Validate(); err != nil {
+		abortWithError(c, err)
+		return store.LeaderboardQuery{}, false
+	}
+	return query, true
 }
 
 // Utilitaires de conversion
@@ -315,10 +494,24 @@ func CorePlayerToJSON(player *core.Player) PlayerJSON {
 		Name:      player.Name,
 		XP:        player.XP,
 		Level:     player.Level,
+		Team:      player.Team,
 		Inventory: player.Inventory,
 	}
 }
 
+// rankedToJSON convertit des joueurs classés (sans inventaire)
+func rankedToJSON(players []store.RankedPlayer) []LeaderboardEntryJSON {
+	entries := make([]LeaderboardEntryJSON, len(players))
+	for i, player := range players {
+		entries[i] = LeaderboardEntryJSON{
+			PlayerJSON: PlayerJSON{ID: player.ID, Name: player.Name, XP: player.XP, Level: player.Level, Team: player.Team},
+			Rank:       player.Rank,
+			Score:      player.Score,
+		}
+	}
+	return entries
+}
+
 // CoreWordToJSON convertit un core.Word en WordJSON
 func CoreWordToJSON(word *core.Word) WordJSON {
 	return WordJSON{
@@ -328,3 +521,8 @@ func CoreWordToJSON(word *core.Word) WordJSON {
 		Points: word.Points,
 	}
 }
+
+// CoreSpawnToJSON convertit un core.Spawn en SpawnJSON
+func CoreSpawnToJSON(spawn *core.Spawn) SpawnJSON {
+	return SpawnJSON{SpawnID: spawn.BattleID, WordJSON: CoreWordToJSON(&spawn.Word)}
+}
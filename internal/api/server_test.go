@@ -0,0 +1,113 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestWrongAttemptFleesUnderFirstAttemptWins(t *testing.T) {
+	server, _ := newTestServer(t)
+	alice := createTestPlayer(t, server, "Alice")
+
+	w := doJSON(server, http.MethodPost, "/encounter/attempt", alice.Token, `{"attempt":"xxxx"}`)
+	var response AttemptResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if w.Code != http.StatusOK || response.Status != "fled" {
+		t.Fatalf("fuite attendue: %d %s", w.Code, w.Body.String())
+	}
+
+	if w := doJSON(server, http.MethodGet, "/spawn/current", "", ""); w.Code != http.StatusNotFound {
+		t.Errorf("spawn retiré attendu, obtenu %d", w.Code)
+	}
+}
+
+func TestCaptureCreditsPlayerThroughStore(t *testing.T) {
+	ctx := t.Context()
+	server, memStore := newTestServer(t)
+	alice := createTestPlayer(t, server, "Alice")
+
+	doJSON(server, http.MethodPost, "/encounter/attempt", alice.Token, `{"attempt":"tach"}`)
+
+	w := doJSON(server, http.MethodGet, "/players/"+alice.ID, "", "")
+	var player PlayerJSON
+	json.Unmarshal(w.Body.Bytes(), &player)
+	if player.XP != 5 || player.Inventory["c_1"] != 1 {
+		t.Errorf("capture non créditée: %s", w.Body.String())
+	}
+
+	captured, _ := memStore.ListByPlayer(ctx, alice.ID)
+	if len(captured) != 1 || captured[0].ID != "c_1" {
+		t.Errorf("captures inattendues: %+v", captured)
+	}
+}
+
+func TestCreatePlayerRejectsDuplicateName(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	if w := doJSON(server, http.MethodPost, "/players", "", `{"name":"Alice"}`); w.Code != http.StatusCreated {
+		t.Fatalf("création: attendu 201, obtenu %d", w.Code)
+	}
+	if w := doJSON(server, http.MethodPost, "/players", "", `{"name":"Alice"}`); w.Code != http.StatusConflict {
+		t.Errorf("doublon: attendu 409, obtenu %d", w.Code)
+	}
+}
+
+func TestLeaderboardPagesWithCursorAndFilters(t *testing.T) {
+	ctx := t.Context()
+	server, memStore := newTestServer(t)
+	for i, name := range []string{"Alice", "Bob", "Chloé"} {
+		player := createTestPlayer(t, server, name)
+		memStore.UpdateXP(ctx, player.ID, 10*(i+1), 1)
+	}
+	doJSON(server, http.MethodPost, "/players", "", `{"name":"David","team":"rouge"}`)
+
+	w := doJSON(server, http.MethodGet, "/v1/leaderboard?limit=2", "", "")
+	var page []LeaderboardEntryJSON
+	json.Unmarshal(w.Body.Bytes(), &page)
+	cursor := w.Header().Get(nextCursorHeader)
+	if w.Code != http.StatusOK || len(page) != 2 || page[0].Name != "Chloé" || page[0].Rank != 1 || cursor == "" {
+		t.Fatalf("première page inattendue: %d %s", w.Code, w.Body.String())
+	}
+	if w.Header().Get(totalCountHeader) != "4" {
+		t.Errorf("X-Total-Count: attendu 4, obtenu %q", w.Header().Get(totalCountHeader))
+	}
+
+	w = doJSON(server, http.MethodGet, "/v1/leaderboard?limit=2&cursor="+cursor, "", "")
+	json.Unmarshal(w.Body.Bytes(), &page)
+	if len(page) != 2 || page[0].Name != "Alice" || page[1].Team != "rouge" || w.Header().Get(nextCursorHeader) != "" {
+		t.Errorf("dernière page inattendue: %s", w.Body.String())
+	}
+
+	if w := doJSON(server, http.MethodGet, "/v1/leaderboard?team=rouge", "", ""); w.Header().Get(totalCountHeader) != "1" {
+		t.Errorf("filtre équipe: un joueur attendu, obtenu %s", w.Body.String())
+	}
+	if w := doJSON(server, http.MethodGet, "/v1/leaderboard?by=inconnu", "", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("critère inconnu: attendu 400, obtenu %d", w.Code)
+	}
+}
+
+func TestPlayerRankReturnsNeighbors(t *testing.T) {
+	ctx := t.Context()
+	server, memStore := newTestServer(t)
+	var ids []string
+	for i, name := range []string{"Alice", "Bob", "Chloé"} {
+		player := createTestPlayer(t, server, name)
+		memStore.UpdateXP(ctx, player.ID, 10*(i+1), 1)
+		ids = append(ids, player.ID)
+	}
+
+	w := doJSON(server, http.MethodGet, "/v1/players/"+ids[1]+"/rank", "", "")
+	var rank PlayerRankJSON
+	json.Unmarshal(w.Body.Bytes(), &rank)
+	if w.Code != http.StatusOK || rank.Rank != 2 || rank.Total != 3 || rank.By != "xp" {
+		t.Fatalf("rang de Bob inattendu: %d %s", w.Code, w.Body.String())
+	}
+	if len(rank.Above) != 1 || rank.Above[0].Name != "Chloé" || len(rank.Below) != 1 || rank.Below[0].Name != "Alice" {
+		t.Errorf("voisins inattendus: %s", w.Body.String())
+	}
+
+	if w := doJSON(server, http.MethodGet, "/v1/players/inconnu/rank", "", ""); w.Code != http.StatusNotFound {
+		t.Errorf("joueur inconnu: attendu 404, obtenu %d", w.Code)
+	}
+}
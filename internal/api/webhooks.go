@@ -0,0 +1,177 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookOptionsFromConfig convertit la section webhooks de la config
+func WebhookOptionsFromConfig(gameConfig *config.GameConfig) webhook.Options {
+	opts := webhook.DefaultOptions()
+	opts.MaxAttempts = gameConfig.Webhooks.MaxAttempts
+	opts.InitialBackoff = time.Duration(gameConfig.Webhooks.InitialBackoffMs) * time.Millisecond
+	opts.MaxBackoff = time.Duration(gameConfig.Webhooks.MaxBackoffMs) * time.Millisecond
+	opts.Timeout = time.Duration(gameConfig.Webhooks.TimeoutMs) * time.Millisecond
+	return opts
+}
+
+// CreateWebhookRequest corps de POST /admin/webhooks
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" binding:"required"`
+	Events []string `json:"events,omitempty"`
+	Secret string   `json:"secret,omitempty"`
+}
+
+// webhookAdmin expose l'administration des webhooks
+type webhookAdmin struct {
+	store webhook.Store
+}
+
+// registerWebhookRoutes ajoute les routes /admin/webhooks, protégées par le jeton admin
+func registerWebhookRoutes(router gin.IRouter, store webhook.Store, adminToken string) {
+	admin := &webhookAdmin{store: store}
+
+	group := router.Group("/admin/webhooks", requireAdmin(adminToken))
+	group.POST("", admin.create)
+	group.GET("", admin.list)
+	group.GET("/:id", admin.get)
+	group.DELETE("/:id", admin.delete)
+	group.GET("/:id/deliveries", admin.deliveries)
+}
+
+// requireAdmin vérifie l'en-tête "Authorization: Bearer <jeton admin>".
+// Sans jeton configuré, l'administration est désactivée.
+func requireAdmin(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			abortWithCode(c, http.StatusServiceUnavailable, CodeUnavailable,
+				"administration désactivée (WORDMON_ADMIN_TOKEN non défini)")
+			return
+		}
+
+		given := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			abortWithCode(c, http.StatusUnauthorized, CodeUnauthorized, "jeton admin invalide")
+			return
+		}
+		c.Next()
+	}
+}
+
+// create enregistre un abonnement ; le secret n'est retourné qu'à la création
+func (a *webhookAdmin) create(c *gin.Context) {
+	var req CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		abortWithCode(c, http.StatusBadRequest, CodeInvalidRequest, "url requise")
+		return
+	}
+
+	target, err := url.Parse(req.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		abortWithCode(c, http.StatusBadRequest, CodeInvalidRequest, "url invalide (http ou https attendu)")
+		return
+	}
+
+	if len(req.Events) == 0 {
+		req.Events = webhook.Events
+	}
+	if err := webhook.ValidateEvents(req.Events); err != nil {
+		abortWithCode(c, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+		return
+	}
+
+	if req.Secret == "" {
+		if req.Secret, err = webhook.NewSecret(); err != nil {
+			abortWithError(c, err)
+			return
+		}
+	}
+
+	sub := &webhook.Subscription{
+		URL:    req.URL,
+		Secret: req.Secret,
+		Events: req.Events,
+		Active: true,
+	}
+	if err := a.store.CreateWebhook(c.Request.Context(), sub); err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sub)
+}
+
+// list retourne les abonnements (sans leur secret)
+func (a *webhookAdmin) list(c *gin.Context) {
+	subs, err := a.store.ListWebhooks(c.Request.Context())
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	response := make([]webhook.Subscription, len(subs))
+	for i, sub := range subs {
+		sub.Secret = ""
+		response[i] = sub
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// get retourne un abonnement (sans son secret)
+func (a *webhookAdmin) get(c *gin.Context) {
+	sub, err := a.store.GetWebhook(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	sub.Secret = ""
+	c.JSON(http.StatusOK, sub)
+}
+
+// delete supprime un abonnement
+func (a *webhookAdmin) delete(c *gin.Context) {
+	if err := a.store.DeleteWebhook(c.Request.Context(), c.Param("id")); err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// deliveries retourne le journal des livraisons (?status=pending|delivered|dead&limit=50)
+func (a *webhookAdmin) deliveries(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := a.store.GetWebhook(c.Request.Context(), id); err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	deliveries, err := a.store.ListDeliveries(c.Request.Context(), id, c.Query("status"), limit)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	if deliveries == nil {
+		deliveries = []webhook.Delivery{}
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
@@ -0,0 +1,271 @@
+// Package auth émet et vérifie les jetons d'API des joueurs.
+//
+// Un jeton a la forme "wm1.<payload>.<signature>" : payload est le JSON
+// base64url {tid, pid, iat} et signature le HMAC-SHA256 du préfixe avec le
+// secret du serveur. Seule l'empreinte SHA-256 du jeton est stockée, ce qui
+// permet la rotation et la révocation sans jamais conserver le jeton en clair.
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Préfixe de version des jetons
+const tokenPrefix = "wm1"
+
+// Erreurs d'authentification
+var (
+	ErrInvalidToken  = errors.New("jeton invalide")
+	ErrRevokedToken  = errors.New("jeton révoqué")
+	ErrTokenNotFound = errors.New("jeton introuvable") // retournée par TokenStore.GetToken
+)
+
+// Token jeton d'API enregistré pour un joueur (empreinte seulement)
+type Token struct {
+	ID        string
+	PlayerID  string
+	Hash      string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// Revoked indique si le jeton a été révoqué
+func (t Token) Revoked() bool {
+	return t.RevokedAt != nil
+}
+
+// TokenStore persistance des jetons des joueurs. GetToken signale un jeton
+// inconnu par une erreur qui satisfait errors.Is(err, ErrTokenNotFound) ;
+// toute autre erreur est une panne du stockage.
+type TokenStore interface {
+	SaveToken(ctx context.Context, t *Token) error
+	GetToken(ctx context.Context, id string) (*Token, error)
+	RevokeToken(ctx context.Context, id string, at time.Time) error
+	RevokePlayerTokens(ctx context.Context, playerID string, at time.Time) error
+}
+
+// claims contenu signé du jeton
+type claims struct {
+	TokenID  string `json:"tid"`
+	PlayerID string `json:"pid"`
+	IssuedAt int64  `json:"iat"`
+}
+
+// Issuer émet, vérifie, fait tourner et révoque les jetons
+type Issuer struct {
+	secret []byte
+	store  TokenStore
+}
+
+// NewIssuer crée un émetteur de jetons signés avec secret
+func NewIssuer(secret []byte, store TokenStore) *Issuer {
+	return &Issuer{secret: secret, store: store}
+}
+
+// NewSecret génère un secret de signature aléatoire
+func NewSecret() ([]byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("erreur génération secret: %w", err)
+	}
+	return secret, nil
+}
+
+// LoadOrCreateSecret lit le secret conservé dans path ou, au premier appel,
+// en génère un et l'y écrit (lisible du seul propriétaire) ; created indique
+// qu'il vient d'être généré
+func LoadOrCreateSecret(path string) (secret []byte, created bool, err error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		secret = []byte(strings.TrimSpace(string(data)))
+		if len(secret) == 0 {
+			return nil, false, fmt.Errorf("secret vide dans %s", path)
+		}
+		return secret, false, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, false, fmt.Errorf("erreur lecture secret: %w", err)
+	}
+
+	raw, err := NewSecret()
+	if err != nil {
+		return nil, false, err
+	}
+	secret = []byte(hex.EncodeToString(raw))
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, false, fmt.Errorf("erreur création répertoire du secret: %w", err)
+	}
+	// O_EXCL : deux démarrages simultanés ne s'écrasent pas leur secret
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if errors.Is(err, os.ErrExist) {
+		return LoadOrCreateSecret(path)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("erreur écriture secret: %w", err)
+	}
+	if _, err := file.Write(append(secret, '\n')); err != nil {
+		file.Close()
+		return nil, false, fmt.Errorf("erreur écriture secret: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, false, fmt.Errorf("erreur écriture secret: %w", err)
+	}
+	return secret, true, nil
+}
+
+// Issue crée un nouveau jeton pour un joueur ; le jeton en clair n'est
+// retourné qu'ici
+func (i *Issuer) Issue(ctx context.Context, playerID string) (string, *Token, error) {
+	now := time.Now().UTC()
+	c := claims{TokenID: uuid.New().String(), PlayerID: playerID, IssuedAt: now.Unix()}
+
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", nil, fmt.Errorf("erreur encodage jeton: %w", err)
+	}
+	unsigned := tokenPrefix + "." + base64.RawURLEncoding.EncodeToString(payload)
+	raw := unsigned + "." + i.sign(unsigned)
+
+	token := &Token{
+		ID:        c.TokenID,
+		PlayerID:  playerID,
+		Hash:      HashToken(raw),
+		CreatedAt: now,
+	}
+	if err := i.store.SaveToken(ctx, token); err != nil {
+		return "", nil, fmt.Errorf("erreur enregistrement jeton: %w", err)
+	}
+
+	return raw, token, nil
+}
+
+// Authenticate vérifie la signature du jeton puis son empreinte et son
+// statut en base ; retourne le jeton enregistré. Une panne du stockage est
+// retournée telle quelle : le jeton n'est pas pour autant invalide.
+func (i *Issuer) Authenticate(ctx context.Context, raw string) (*Token, error) {
+	c, err := i.parse(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := i.store.GetToken(ctx, c.TokenID)
+	if errors.Is(err, ErrTokenNotFound) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture jeton: %w", err)
+	}
+	if token.PlayerID != c.PlayerID ||
+		subtle.ConstantTimeCompare([]byte(token.Hash), []byte(HashToken(raw))) != 1 {
+		return nil, ErrInvalidToken
+	}
+	if token.Revoked() {
+		return nil, ErrRevokedToken
+	}
+
+	return token, nil
+}
+
+// Rotate émet un nouveau jeton pour le joueur du jeton courant puis révoque ce dernier
+func (i *Issuer) Rotate(ctx context.Context, current *Token) (string, *Token, error) {
+	raw, token, err := i.Issue(ctx, current.PlayerID)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := i.Revoke(ctx, current.ID); err != nil {
+		return "", nil, err
+	}
+	return raw, token, nil
+}
+
+// Revoke révoque un jeton
+func (i *Issuer) Revoke(ctx context.Context, tokenID string) error {
+	if err := i.store.RevokeToken(ctx, tokenID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("erreur révocation jeton: %w", err)
+	}
+	return nil
+}
+
+// RevokeAll révoque tous les jetons d'un joueur
+func (i *Issuer) RevokeAll(ctx context.Context, playerID string) error {
+	if err := i.store.RevokePlayerTokens(ctx, playerID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("erreur révocation jetons: %w", err)
+	}
+	return nil
+}
+
+// parse vérifie le format et la signature d'un jeton
+func (i *Issuer) parse(raw string) (claims, error) {
+	var c claims
+
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 || parts[0] != tokenPrefix {
+		return c, ErrInvalidToken
+	}
+
+	unsigned := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(i.sign(unsigned))) {
+		return c, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return c, ErrInvalidToken
+	}
+	if err := json.Unmarshal(payload, &c); err != nil || c.TokenID == "" || c.PlayerID == "" {
+		return c, ErrInvalidToken
+	}
+
+	return c, nil
+}
+
+// sign calcule la signature base64url d'un préfixe de jeton
+func (i *Issuer) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, i.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// HashToken retourne l'empreinte SHA-256 hexadécimale stockée pour un jeton
+func HashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// contextKey clé des valeurs d'authentification dans un context.Context
+type contextKey struct{}
+
+// WithToken retourne un contexte portant le jeton authentifié
+func WithToken(ctx context.Context, token *Token) context.Context {
+	return context.WithValue(ctx, contextKey{}, token)
+}
+
+// TokenFromContext retourne le jeton authentifié du contexte, s'il existe
+func TokenFromContext(ctx context.Context) (*Token, bool) {
+	token, ok := ctx.Value(contextKey{}).(*Token)
+	return token, ok
+}
+
+// PlayerIDFromContext retourne l'ID du joueur authentifié du contexte
+func PlayerIDFromContext(ctx context.Context) (string, bool) {
+	token, ok := TokenFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	return token.PlayerID, true
+}
@@ -0,0 +1,112 @@
+package auth_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+func TestIssuerAuthenticatesIssuedToken(t *testing.T) {
+	ctx := t.Context()
+	memStore := store.NewMemoryStore()
+	issuer := auth.NewIssuer([]byte("secret"), memStore)
+
+	raw, issued, err := issuer.Issue(ctx, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Seule l'empreinte est stockée
+	stored, _ := memStore.GetToken(ctx, issued.ID)
+	if stored.Hash == raw || stored.Hash != auth.HashToken(raw) {
+		t.Errorf("empreinte stockée inattendue: %s", stored.Hash)
+	}
+
+	token, err := issuer.Authenticate(ctx, raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.PlayerID != "p1" {
+		t.Errorf("joueur inattendu: %s", token.PlayerID)
+	}
+}
+
+func TestIssuerRejectsForgedTokens(t *testing.T) {
+	ctx := t.Context()
+	memStore := store.NewMemoryStore()
+	issuer := auth.NewIssuer([]byte("secret"), memStore)
+	raw, _, _ := issuer.Issue(ctx, "p1")
+
+	// Même jeton vérifié avec un autre secret
+	other := auth.NewIssuer([]byte("autre"), memStore)
+	if _, err := other.Authenticate(ctx, raw); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("jeton d'un autre secret accepté: %v", err)
+	}
+
+	// Signature modifiée
+	tampered := raw[:len(raw)-2] + "xx"
+	if _, err := issuer.Authenticate(ctx, tampered); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("jeton modifié accepté: %v", err)
+	}
+
+	if _, err := issuer.Authenticate(ctx, strings.Repeat("a", 40)); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("jeton mal formé accepté: %v", err)
+	}
+}
+
+func TestIssuerRotateAndRevoke(t *testing.T) {
+	ctx := t.Context()
+	memStore := store.NewMemoryStore()
+	issuer := auth.NewIssuer([]byte("secret"), memStore)
+	first, firstToken, _ := issuer.Issue(ctx, "p1")
+
+	second, _, err := issuer.Rotate(ctx, firstToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := issuer.Authenticate(ctx, first); !errors.Is(err, auth.ErrRevokedToken) {
+		t.Errorf("l'ancien jeton devrait être révoqué: %v", err)
+	}
+	if _, err := issuer.Authenticate(ctx, second); err != nil {
+		t.Errorf("le nouveau jeton devrait être valide: %v", err)
+	}
+
+	third, _, _ := issuer.Issue(ctx, "p1")
+	if err := issuer.RevokeAll(ctx, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	for _, raw := range []string{second, third} {
+		if _, err := issuer.Authenticate(ctx, raw); !errors.Is(err, auth.ErrRevokedToken) {
+			t.Errorf("jeton non révoqué après RevokeAll: %v", err)
+		}
+	}
+}
+
+func TestLoadOrCreateSecretSurvivesRestart(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "auth", "secret")
+	memStore := store.NewMemoryStore()
+
+	secret, created, err := auth.LoadOrCreateSecret(path)
+	if err != nil || !created {
+		t.Fatalf("génération attendue: %v", err)
+	}
+	raw, _, _ := auth.NewIssuer(secret, memStore).Issue(ctx, "p1")
+
+	// Redémarrage : même secret, le jeton conservé reste valable
+	again, created, err := auth.LoadOrCreateSecret(path)
+	if err != nil || created || string(again) != string(secret) {
+		t.Fatalf("secret relu attendu, obtenu créé=%v (%v)", created, err)
+	}
+	if _, err := auth.NewIssuer(again, memStore).Authenticate(ctx, raw); err != nil {
+		t.Errorf("jeton refusé après redémarrage: %v", err)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("permissions 0600 attendues, obtenu %v", info.Mode().Perm())
+	}
+}
@@ -0,0 +1,225 @@
+// Package backup exporte et importe l'état complet d'une partie entre deux
+// implémentations de store.Store.
+//
+// Le format est du NDJSON versionné : une enveloppe {"type", "data"} par
+// ligne. Le fichier commence par un en-tête, liste les mots, puis chaque
+// joueur suivi de ses captures et de ses jetons, puis les webhooks, et se
+// termine par les compteurs d'intégrité. Un lecteur ignore (en les comptant)
+// les types d'enregistrement qu'il ne connaît pas, ce qui permet d'ajouter de
+// nouvelles tables sans changer de version.
+package backup
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+)
+
+// Identifiant et version du format
+const (
+	Format  = "wordmon-dump"
+	Version = 1
+)
+
+// Types d'enregistrement
+const (
+	typeHeader  = "header"
+	typeWord    = "word"
+	typeVersion = "catalogVersion"
+	typePlayer  = "player"
+	typeCapture = "capture"
+	typeToken   = "token"
+	typeWebhook = "webhook"
+	typeCounts  = "counts"
+)
+
+// Header premier enregistrement du fichier
+type Header struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	Source    string    `json:"source"` // backend d'origine (memory, file, sql, gorm)
+}
+
+// Counts compteurs d'intégrité, dernier enregistrement du fichier
+type Counts struct {
+	Words           int `json:"words"`
+	CatalogVersions int `json:"catalogVersions,omitempty"`
+	Players         int `json:"players"`
+	Captures        int `json:"captures"`
+	Tokens          int `json:"tokens"`
+	Webhooks        int `json:"webhooks"`
+}
+
+// envelope une ligne du fichier
+type envelope struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// wordRecord mot du catalogue ; un mot retiré reste au catalogue pour les
+// captures existantes mais n'apparaît plus en rencontre
+type wordRecord struct {
+	ID      string `json:"id"`
+	Text    string `json:"text"`
+	Rarity  string `json:"rarity"`
+	Points  int    `json:"points"`
+	Retired bool   `json:"retired,omitempty"`
+}
+
+// playerRecord joueur, sans inventaire (reconstruit par ses captures)
+type playerRecord struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	XP    int    `json:"xp"`
+	Level int    `json:"level"`
+	Team  string `json:"team,omitempty"`
+}
+
+// captureRecord capture datée d'un joueur
+type captureRecord struct {
+	PlayerID   string    `json:"playerId"`
+	WordID     string    `json:"wordId"`
+	CapturedAt time.Time `json:"capturedAt"`
+}
+
+// tokenRecord jeton d'un joueur (empreinte seulement)
+type tokenRecord struct {
+	ID        string     `json:"id"`
+	PlayerID  string     `json:"playerId"`
+	Hash      string     `json:"hash"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// encoder écrit les enveloppes, une par ligne
+type encoder struct {
+	enc *json.Encoder
+}
+
+func (e encoder) write(recordType string, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("erreur encodage %s: %w", recordType, err)
+	}
+	if err := e.enc.Encode(envelope{Type: recordType, Data: raw}); err != nil {
+		return fmt.Errorf("erreur écriture %s: %w", recordType, err)
+	}
+	return nil
+}
+
+// Dump écrit l'état complet de src dans w. Les webhooks ne sont exportés que
+// si src implémente webhook.Store. Toute erreur de lecture interrompt le
+// dump, sans compteurs : un dump tronqué est refusé par Restore.
+func Dump(ctx context.Context, src store.Store, w io.Writer, source string) (Counts, error) {
+	var counts Counts
+	buf := bufio.NewWriter(w)
+	out := encoder{enc: json.NewEncoder(buf)}
+
+	header := Header{Format: Format, Version: Version, CreatedAt: time.Now().UTC(), Source: source}
+	if err := out.write(typeHeader, header); err != nil {
+		return counts, err
+	}
+
+	words, err := src.ListCatalog(ctx)
+	if err != nil {
+		return counts, fmt.Errorf("erreur lecture mots: %w", err)
+	}
+	for _, word := range words {
+		record := wordRecord{ID: word.ID, Text: word.Text, Rarity: string(word.Rarity), Points: word.Points, Retired: word.Retired}
+		if err := out.write(typeWord, record); err != nil {
+			return counts, err
+		}
+		counts.Words++
+	}
+
+	versions, err := src.ListCatalogVersions(ctx)
+	if err != nil {
+		return counts, fmt.Errorf("erreur lecture versions catalogue: %w", err)
+	}
+	for _, version := range versions {
+		if err := out.write(typeVersion, version); err != nil {
+			return counts, err
+		}
+		counts.CatalogVersions++
+	}
+
+	players, err := src.List(ctx, 0)
+	if err != nil {
+		return counts, fmt.Errorf("erreur lecture joueurs: %w", err)
+	}
+	for _, player := range players {
+		if err := dumpPlayer(ctx, src, out, player, &counts); err != nil {
+			return counts, err
+		}
+	}
+
+	if hooks, ok := src.(webhook.Store); ok {
+		subs, err := hooks.ListWebhooks(ctx)
+		if err != nil {
+			return counts, fmt.Errorf("erreur lecture webhooks: %w", err)
+		}
+		for _, sub := range subs {
+			if err := out.write(typeWebhook, sub); err != nil {
+				return counts, err
+			}
+			counts.Webhooks++
+		}
+	}
+
+	if err := out.write(typeCounts, counts); err != nil {
+		return counts, err
+	}
+	if err := buf.Flush(); err != nil {
+		return counts, fmt.Errorf("erreur écriture dump: %w", err)
+	}
+	return counts, nil
+}
+
+// dumpPlayer écrit un joueur, ses captures puis ses jetons
+func dumpPlayer(ctx context.Context, src store.Store, out encoder, player core.Player, counts *Counts) error {
+	record := playerRecord{ID: player.ID, Name: player.Name, XP: player.XP, Level: player.Level, Team: player.Team}
+	if err := out.write(typePlayer, record); err != nil {
+		return err
+	}
+	counts.Players++
+
+	captures, err := src.ListCaptures(ctx, player.ID)
+	if err != nil {
+		return fmt.Errorf("erreur lecture captures de %s: %w", player.ID, err)
+	}
+	for _, capture := range captures {
+		if err := out.write(typeCapture, captureRecord{PlayerID: player.ID, WordID: capture.WordID, CapturedAt: capture.CapturedAt}); err != nil {
+			return err
+		}
+		counts.Captures++
+	}
+
+	tokens, err := src.ListTokens(ctx, player.ID)
+	if err != nil {
+		return fmt.Errorf("erreur lecture jetons de %s: %w", player.ID, err)
+	}
+	for _, token := range tokens {
+		if err := out.write(typeToken, fromToken(token)); err != nil {
+			return err
+		}
+		counts.Tokens++
+	}
+	return nil
+}
+
+func fromToken(t auth.Token) tokenRecord {
+	return tokenRecord{ID: t.ID, PlayerID: t.PlayerID, Hash: t.Hash, CreatedAt: t.CreatedAt, RevokedAt: t.RevokedAt}
+}
+
+func (r tokenRecord) token(playerID string) *auth.Token {
+	return &auth.Token{ID: r.ID, PlayerID: playerID, Hash: r.Hash, CreatedAt: r.CreatedAt, RevokedAt: r.RevokedAt}
+}
@@ -0,0 +1,224 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+)
+
+var testWords = []core.Word{
+	{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5},
+	{ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 50},
+}
+
+// fillMemory crée une partie : deux joueurs, des captures, un jeton, un webhook
+func fillMemory(t *testing.T) *store.MemoryStore {
+	t.Helper()
+	ctx := t.Context()
+	src := store.NewMemoryStore()
+	src.Seed(ctx, testWords)
+	alice, _ := src.Create(ctx, "Alice")
+	bob, _ := src.Create(ctx, "Bob")
+	src.Add(ctx, alice.ID, "l_1")
+	src.Add(ctx, alice.ID, "c_1")
+	src.Add(ctx, bob.ID, "c_1")
+	src.SetTeam(ctx, alice.ID, "rouge")
+	src.UpdateXP(ctx, bob.ID, 120, core.LevelFromXP(120)) // XP ajustée à la main
+	src.SaveToken(ctx, &auth.Token{ID: "t1", PlayerID: alice.ID, Hash: "h", CreatedAt: time.Now().UTC()})
+	src.CreateWebhook(ctx, &webhook.Subscription{URL: "http://example.test", Events: []string{"capture"}, Active: true})
+	return src
+}
+
+func TestDumpRestoreRoundTrip(t *testing.T) {
+	ctx := t.Context()
+	var buf bytes.Buffer
+	counts, err := Dump(ctx, fillMemory(t), &buf, "memory")
+	if err != nil {
+		t.Fatalf("dump: %v", err)
+	}
+	if counts != (Counts{Words: 2, Players: 2, Captures: 3, Tokens: 1, Webhooks: 1}) {
+		t.Errorf("compteurs inattendus: %+v", counts)
+	}
+
+	dst, err := store.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("ouverture: %v", err)
+	}
+	defer dst.Close()
+
+	report, err := Restore(ctx, dst, &buf, nil)
+	if err != nil {
+		t.Fatalf("restauration: %v", err)
+	}
+	if report.Counts != counts || report.Remapped != 0 {
+		t.Errorf("bilan inattendu: %+v", report)
+	}
+	if len(report.XPMismatches) != 1 || report.XPMismatches[0].Name != "Bob" || report.XPMismatches[0].CapturePoints != 5 {
+		t.Errorf("écart d'XP de Bob attendu: %+v", report.XPMismatches)
+	}
+
+	alice, err := dst.Get(ctx, "p1")
+	if err != nil || alice.XP != 55 || alice.Team != "rouge" || alice.Inventory["l_1"] != 1 {
+		t.Errorf("Alice mal restaurée: %+v (%v)", alice, err)
+	}
+	if page, _ := dst.Leaderboard(ctx, store.LeaderboardQuery{By: store.RankByLegendary}); page.Players[0].Score != 1 {
+		t.Errorf("classement non reconstruit: %+v", page.Players)
+	}
+	if _, err := dst.GetToken(ctx, "t1"); err != nil {
+		t.Errorf("jeton perdu: %v", err)
+	}
+
+	// Les IDs générés reprennent après les joueurs restaurés
+	if carol, _ := dst.Create(ctx, "Carol"); carol.ID != "p3" {
+		t.Errorf("ID p3 attendu, obtenu %s", carol.ID)
+	}
+}
+
+func TestRestoreRejectsBadDumps(t *testing.T) {
+	ctx := t.Context()
+	var buf bytes.Buffer
+	Dump(ctx, fillMemory(t), &buf, "memory")
+	dump := buf.String()
+	lines := strings.SplitAfter(strings.TrimSuffix(dump, "\n"), "\n")
+
+	cases := map[string]string{
+		"tronqué":           strings.Join(lines[:len(lines)-1], ""),
+		"version future":    strings.Replace(dump, `"version":1`, `"version":99`, 1),
+		"compteurs faux":    strings.Replace(dump, `"captures":3`, `"captures":4`, 1),
+		"sans en-tête":      strings.Join(lines[1:], ""),
+		"fichier inconnu":   `{"type":"header","data":{"format":"autre","version":1}}`,
+		"ligne illisible":   dump + "{",
+		"après compteurs":   dump + `{"type":"word","data":{}}` + "\n",
+		"aucune donnée":     "",
+		"capture orpheline": strings.Replace(dump, `"playerId":"p2"`, `"playerId":"p9"`, 1),
+	}
+	for name, input := range cases {
+		if _, err := Restore(ctx, store.NewMemoryStore(), strings.NewReader(input), nil); err == nil {
+			t.Errorf("%s: erreur attendue", name)
+		}
+	}
+
+	if _, err := Restore(ctx, fillMemory(t), strings.NewReader(dump), nil); !errors.Is(err, ErrNotEmpty) {
+		t.Errorf("cible non vide: ErrNotEmpty attendu, obtenu %v", err)
+	}
+
+	// Un type inconnu (table future) est compté puis ignoré
+	withFuture := strings.Replace(dump, `{"type":"counts"`, `{"type":"badge","data":{}}`+"\n"+`{"type":"counts"`, 1)
+	report, err := Restore(ctx, store.NewMemoryStore(), strings.NewReader(withFuture), nil)
+	if err != nil || report.Unknown != 1 {
+		t.Errorf("type inconnu: 1 ignoré attendu, obtenu %+v (%v)", report, err)
+	}
+}
+
+func TestImportSnapshot(t *testing.T) {
+	ctx := t.Context()
+	snapshot := &config.GameSnapshot{
+		UpdatedAt: "2025-08-27T12:53:09Z",
+		Players: []config.PlayerSnapshot{
+			{ID: "player_001", Name: "Guest", XP: 60, Level: 1, Inventory: map[string]int{"chat": 2, "l_1": 1, "inconnu": 1}},
+		},
+	}
+
+	dst := store.NewMemoryStore()
+	report, err := ImportSnapshot(ctx, dst, snapshot, testWords, nil)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if report.Counts.Players != 1 || report.Counts.Captures != 3 || report.UnknownWords != 1 {
+		t.Errorf("bilan inattendu: %+v", report)
+	}
+	if len(report.XPMismatches) != 0 {
+		t.Errorf("XP cohérente attendue: %+v", report.XPMismatches)
+	}
+
+	captures, _ := dst.ListCaptures(ctx, "player_001")
+	if len(captures) != 3 || !captures[0].CapturedAt.Equal(time.Date(2025, 8, 27, 12, 53, 9, 0, time.UTC)) {
+		t.Errorf("captures datées du snapshot attendues: %+v", captures)
+	}
+}
+
+func TestRestoreKeepsRetiredWords(t *testing.T) {
+	ctx := t.Context()
+	src := fillMemory(t)
+	if _, err := src.SyncWords(ctx, testWords[:1], true); err != nil { // retire le dragon
+		t.Fatalf("synchronisation: %v", err)
+	}
+
+	var buf bytes.Buffer
+	counts, err := Dump(ctx, src, &buf, "memory")
+	if err != nil || counts.Words != 2 || counts.CatalogVersions != 1 {
+		t.Fatalf("dump: %+v (%v)", counts, err)
+	}
+
+	dst, err := store.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("ouverture: %v", err)
+	}
+	defer dst.Close()
+	if _, err := Restore(ctx, dst, &buf, nil); err != nil {
+		t.Fatalf("restauration: %v", err)
+	}
+
+	if _, err := dst.RandomByRarity(ctx, string(core.Legendary)); err == nil {
+		t.Error("mot retiré proposé en rencontre après restauration")
+	}
+	if word, err := dst.GetWord(ctx, "l_1"); err != nil || word.Points != 50 {
+		t.Errorf("mot retiré perdu: %+v (%v)", word, err)
+	}
+	versions, _ := dst.ListCatalogVersions(ctx)
+	if len(versions) != 1 || versions[0].Retired != 1 {
+		t.Errorf("historique du catalogue perdu: %+v", versions)
+	}
+	if sync, err := dst.SyncWords(ctx, testWords[:1], false); err != nil || !sync.Empty() || sync.Version != 1 {
+		t.Errorf("catalogue restauré différent de la source: %+v (%v)", sync, err)
+	}
+}
+
+// remappingStore renomme chaque joueur restauré, comme Postgres pour un ID
+// qui n'est pas un UUID
+type remappingStore struct {
+	*store.MemoryStore
+}
+
+func (s remappingStore) RestorePlayer(ctx context.Context, p core.Player) (string, error) {
+	p.ID = "new_" + p.ID
+	return s.MemoryStore.RestorePlayer(ctx, p)
+}
+
+func TestRestoreReissuesRemappedTokens(t *testing.T) {
+	ctx := t.Context()
+	var buf bytes.Buffer
+	Dump(ctx, fillMemory(t), &buf, "memory")
+	dump := buf.String()
+
+	// Sans émetteur, Alice resterait sans jeton valable
+	if _, err := Restore(ctx, remappingStore{store.NewMemoryStore()}, strings.NewReader(dump), nil); !errors.Is(err, ErrTokensLost) {
+		t.Errorf("ErrTokensLost attendu, obtenu %v", err)
+	}
+
+	dst := remappingStore{store.NewMemoryStore()}
+	issuer := auth.NewIssuer([]byte("secret"), dst)
+	report, err := Restore(ctx, dst, strings.NewReader(dump), issuer)
+	if err != nil {
+		t.Fatalf("restauration: %v", err)
+	}
+	if report.Remapped != 2 || report.SkippedTokens != 1 || len(report.Reissued) != 1 {
+		t.Fatalf("un jeton réémis attendu: %+v", report)
+	}
+	reissued := report.Reissued[0]
+	if reissued.OldID != "p1" || reissued.PlayerID != "new_p1" || reissued.Name != "Alice" {
+		t.Errorf("jeton réémis inattendu: %+v", reissued)
+	}
+	if token, err := issuer.Authenticate(ctx, reissued.Token); err != nil || token.PlayerID != "new_p1" {
+		t.Errorf("jeton réémis refusé: %+v (%v)", token, err)
+	}
+}
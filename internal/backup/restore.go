@@ -0,0 +1,516 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+)
+
+// Erreurs de restauration
+var (
+	ErrNotEmpty   = errors.New("le store cible contient déjà des données")
+	ErrBadFormat  = errors.New("dump invalide")
+	ErrTokensLost = errors.New("jetons de joueurs remappés non repris")
+)
+
+// Report bilan d'une restauration
+type Report struct {
+	Header          Header
+	Counts          Counts // enregistrements écrits dans la cible
+	Remapped        int    // joueurs restaurés sous un nouvel ID
+	SkippedTokens   int    // jetons d'un joueur remappé : leur signature porte l'ancien ID
+	SkippedWebhooks int    // webhooks ignorés, la cible ne les gère pas
+	Unknown         int    // enregistrements de type inconnu, ignorés
+	UnknownWords    int    // entrées d'inventaire sans mot au catalogue (import de snapshot)
+	Reissued        []ReissuedToken
+	XPMismatches    []XPMismatch
+}
+
+// ReissuedToken jeton émis pour un joueur restauré sous un nouvel ID, dont
+// les anciens jetons (signés pour l'ancien ID) ne sont plus valables ; seul
+// moyen pour le joueur de s'authentifier, à lui transmettre
+type ReissuedToken struct {
+	OldID    string `json:"oldId"`
+	PlayerID string `json:"playerId"`
+	Name     string `json:"name"`
+	Token    string `json:"token"`
+}
+
+// XPMismatch joueur dont l'XP diffère de la somme des points de ses captures
+// (XP ajustée à la main, par exemple) ; signalé, pas corrigé
+type XPMismatch struct {
+	PlayerID      string
+	Name          string
+	XP            int
+	CapturePoints int
+}
+
+// restorer état d'une restauration en cours
+type restorer struct {
+	dst    store.Store
+	issuer *auth.Issuer // nil : pas de réémission des jetons
+	report *Report
+
+	words    []store.CatalogWord    // catalogue en attente d'écriture
+	versions []store.CatalogVersion // historique du catalogue en attente
+	seeded   bool                   // catalogue écrit dans la cible
+	points   map[string]int         // points par mot
+	ids      map[string]int         // index dans players, par ancien ID
+	players  []restoredPlayer
+	read     Counts // enregistrements lus dans le dump
+}
+
+// restoredPlayer joueur écrit dans la cible
+type restoredPlayer struct {
+	id            string // ID dans la cible
+	oldID         string
+	name          string
+	xp            int
+	captures      int
+	capturePoints int
+	keptID        bool
+	reissued      bool // nouveau jeton émis
+	locked        bool // jeton actif perdu, aucun émetteur pour le remplacer
+}
+
+func newRestorer(dst store.Store, issuer *auth.Issuer) *restorer {
+	return &restorer{
+		dst:    dst,
+		issuer: issuer,
+		report: &Report{},
+		points: map[string]int{},
+		ids:    map[string]int{},
+	}
+}
+
+// Restore lit un dump depuis r et l'écrit dans dst, qui doit être vide.
+// Les compteurs du dump, puis le contenu de la cible, sont vérifiés à la fin.
+// Un joueur restauré sous un nouvel ID perd ses jetons : issuer lui en émet
+// un nouveau (Report.Reissued) ; sans issuer, la restauration échoue avec
+// ErrTokensLost si un jeton actif est perdu. La restauration n'est pas
+// atomique : après une erreur (rapport non nil), dst est à vider.
+func Restore(ctx context.Context, dst store.Store, r io.Reader, issuer *auth.Issuer) (*Report, error) {
+	if err := checkEmpty(ctx, dst); err != nil {
+		return nil, err
+	}
+
+	rs := newRestorer(dst, issuer)
+	dec := json.NewDecoder(r)
+	var trailer *Counts
+	for line := 1; ; line++ {
+		var record envelope
+		if err := dec.Decode(&record); err == io.EOF {
+			break
+		} else if err != nil {
+			return rs.report, fmt.Errorf("%w: enregistrement %d: %v", ErrBadFormat, line, err)
+		}
+		if trailer != nil {
+			return rs.report, fmt.Errorf("%w: enregistrement %d après les compteurs", ErrBadFormat, line)
+		}
+		if line == 1 {
+			if err := rs.header(record); err != nil {
+				return rs.report, err
+			}
+			continue
+		}
+		if record.Type == typeCounts {
+			trailer = &Counts{}
+			if err := json.Unmarshal(record.Data, trailer); err != nil {
+				return rs.report, fmt.Errorf("%w: compteurs: %v", ErrBadFormat, err)
+			}
+			continue
+		}
+		if err := rs.apply(ctx, record); err != nil {
+			return rs.report, fmt.Errorf("erreur enregistrement %d (%s): %w", line, record.Type, err)
+		}
+	}
+
+	if rs.report.Header.Format == "" {
+		return rs.report, fmt.Errorf("%w: fichier vide", ErrBadFormat)
+	}
+	if trailer == nil {
+		return rs.report, fmt.Errorf("%w: compteurs absents, dump tronqué", ErrBadFormat)
+	}
+	if *trailer != rs.read {
+		return rs.report, fmt.Errorf("%w: compteurs %+v annoncés, %+v lus", ErrBadFormat, *trailer, rs.read)
+	}
+	if err := rs.finish(ctx); err != nil {
+		return rs.report, err
+	}
+	return rs.report, nil
+}
+
+// header vérifie le premier enregistrement
+func (rs *restorer) header(record envelope) error {
+	if record.Type != typeHeader {
+		return fmt.Errorf("%w: en-tête attendu, %q trouvé", ErrBadFormat, record.Type)
+	}
+	header := &rs.report.Header
+	if err := json.Unmarshal(record.Data, header); err != nil {
+		return fmt.Errorf("%w: en-tête: %v", ErrBadFormat, err)
+	}
+	if header.Format != Format {
+		return fmt.Errorf("%w: format %q inconnu", ErrBadFormat, header.Format)
+	}
+	if header.Version < 1 || header.Version > Version {
+		return fmt.Errorf("%w: version %d non supportée (maximum %d)", ErrBadFormat, header.Version, Version)
+	}
+	return nil
+}
+
+// apply écrit un enregistrement dans la cible
+func (rs *restorer) apply(ctx context.Context, record envelope) error {
+	if record.Type != typeWord && record.Type != typeVersion {
+		if err := rs.seed(ctx); err != nil {
+			return err
+		}
+	}
+
+	switch record.Type {
+	case typeWord:
+		var word wordRecord
+		if err := json.Unmarshal(record.Data, &word); err != nil {
+			return err
+		}
+		if rs.seeded {
+			return fmt.Errorf("mot %s après les joueurs", word.ID)
+		}
+		rs.read.Words++
+		rs.words = append(rs.words, store.CatalogWord{
+			Word:    core.Word{ID: word.ID, Text: word.Text, Rarity: core.Rarity(word.Rarity), Points: word.Points},
+			Retired: word.Retired,
+		})
+		rs.points[word.ID] = word.Points
+
+	case typeVersion:
+		var version store.CatalogVersion
+		if err := json.Unmarshal(record.Data, &version); err != nil {
+			return err
+		}
+		if rs.seeded {
+			return fmt.Errorf("version de catalogue %d après les joueurs", version.Version)
+		}
+		rs.read.CatalogVersions++
+		rs.versions = append(rs.versions, version)
+
+	case typePlayer:
+		var player playerRecord
+		if err := json.Unmarshal(record.Data, &player); err != nil {
+			return err
+		}
+		rs.read.Players++
+		return rs.restorePlayer(ctx, core.Player{ID: player.ID, Name: player.Name, XP: player.XP, Level: player.Level, Team: player.Team})
+
+	case typeCapture:
+		var capture captureRecord
+		if err := json.Unmarshal(record.Data, &capture); err != nil {
+			return err
+		}
+		rs.read.Captures++
+		return rs.restoreCapture(ctx, capture.PlayerID, store.Capture{WordID: capture.WordID, CapturedAt: capture.CapturedAt})
+
+	case typeToken:
+		var token tokenRecord
+		if err := json.Unmarshal(record.Data, &token); err != nil {
+			return err
+		}
+		rs.read.Tokens++
+		return rs.restoreToken(ctx, token)
+
+	case typeWebhook:
+		var sub webhook.Subscription
+		if err := json.Unmarshal(record.Data, &sub); err != nil {
+			return err
+		}
+		rs.read.Webhooks++
+		hooks, ok := rs.dst.(webhook.Store)
+		if !ok {
+			rs.report.SkippedWebhooks++
+			return nil
+		}
+		if err := hooks.CreateWebhook(ctx, &sub); err != nil {
+			return err
+		}
+		rs.report.Counts.Webhooks++
+
+	default:
+		rs.report.Unknown++
+	}
+	return nil
+}
+
+// seed écrit le catalogue d'un bloc, avant le premier joueur
+func (rs *restorer) seed(ctx context.Context) error {
+	if rs.seeded {
+		return nil
+	}
+	rs.seeded = true
+	if len(rs.words) == 0 && len(rs.versions) == 0 {
+		return nil
+	}
+	if err := rs.dst.RestoreCatalog(ctx, rs.words, rs.versions); err != nil {
+		return fmt.Errorf("erreur écriture catalogue: %w", err)
+	}
+	rs.report.Counts.Words = len(rs.words)
+	rs.report.Counts.CatalogVersions = len(rs.versions)
+	return nil
+}
+
+// restorePlayer écrit un joueur et retient son nouvel ID
+func (rs *restorer) restorePlayer(ctx context.Context, player core.Player) error {
+	if _, exists := rs.ids[player.ID]; exists {
+		return fmt.Errorf("joueur %s en double", player.ID)
+	}
+	id, err := rs.dst.RestorePlayer(ctx, player)
+	if err != nil {
+		return err
+	}
+	rs.ids[player.ID] = len(rs.players)
+	rs.players = append(rs.players, restoredPlayer{id: id, oldID: player.ID, name: player.Name, xp: player.XP, keptID: id == player.ID})
+	rs.report.Counts.Players++
+	if id != player.ID {
+		rs.report.Remapped++
+	}
+	return nil
+}
+
+// restoreCapture écrit une capture pour le joueur d'ancien ID playerID
+func (rs *restorer) restoreCapture(ctx context.Context, playerID string, capture store.Capture) error {
+	index, exists := rs.ids[playerID]
+	if !exists {
+		return fmt.Errorf("capture d'un joueur absent du dump: %s", playerID)
+	}
+	player := &rs.players[index]
+	if err := rs.dst.RestoreCapture(ctx, player.id, capture); err != nil {
+		return err
+	}
+	player.captures++
+	player.capturePoints += rs.points[capture.WordID]
+	rs.report.Counts.Captures++
+	return nil
+}
+
+// restoreToken écrit un jeton pour son joueur s'il a gardé son ID ; sinon
+// le jeton est perdu et, s'il était actif, un nouveau est émis (une fois par joueur)
+func (rs *restorer) restoreToken(ctx context.Context, token tokenRecord) error {
+	index, exists := rs.ids[token.PlayerID]
+	if !exists {
+		return fmt.Errorf("jeton %s d'un joueur absent du dump: %s", token.ID, token.PlayerID)
+	}
+	player := &rs.players[index]
+	if player.keptID {
+		if err := rs.dst.SaveToken(ctx, token.token(player.id)); err != nil {
+			return err
+		}
+		rs.report.Counts.Tokens++
+		return nil
+	}
+
+	rs.report.SkippedTokens++
+	if token.RevokedAt != nil || player.reissued || player.locked {
+		return nil
+	}
+	if rs.issuer == nil {
+		player.locked = true
+		return nil
+	}
+	raw, _, err := rs.issuer.Issue(ctx, player.id)
+	if err != nil {
+		return fmt.Errorf("erreur réémission jeton de %s: %w", player.id, err)
+	}
+	player.reissued = true
+	rs.report.Reissued = append(rs.report.Reissued, ReissuedToken{OldID: player.oldID, PlayerID: player.id, Name: player.name, Token: raw})
+	return nil
+}
+
+// finish relit la cible : catalogue, joueurs, XP et nombre de captures
+// doivent correspondre à ce qui a été écrit. Échoue avec ErrTokensLost si
+// un joueur a perdu ses jetons actifs sans en recevoir de nouveau.
+func (rs *restorer) finish(ctx context.Context) error {
+	if err := rs.seed(ctx); err != nil {
+		return err
+	}
+
+	words, err := rs.dst.ListCatalog(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur vérification catalogue: %w", err)
+	}
+	if len(words) != rs.report.Counts.Words {
+		return fmt.Errorf("vérification: %d mots attendus, %d dans la cible", rs.report.Counts.Words, len(words))
+	}
+	retired := map[string]bool{}
+	for _, word := range words {
+		retired[word.ID] = word.Retired
+	}
+	for _, word := range rs.words {
+		if retired[word.ID] != word.Retired {
+			return fmt.Errorf("vérification: mot %s retiré=%t attendu dans la cible", word.ID, word.Retired)
+		}
+	}
+	versions, err := rs.dst.ListCatalogVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur vérification versions catalogue: %w", err)
+	}
+	if len(versions) != rs.report.Counts.CatalogVersions {
+		return fmt.Errorf("vérification: %d versions de catalogue attendues, %d dans la cible", rs.report.Counts.CatalogVersions, len(versions))
+	}
+
+	for _, restored := range rs.players {
+		player, err := rs.dst.Get(ctx, restored.id)
+		if err != nil {
+			return fmt.Errorf("vérification joueur %s: %w", restored.id, err)
+		}
+		if player.XP != restored.xp {
+			return fmt.Errorf("vérification joueur %s: XP %d attendue, %d dans la cible", restored.id, restored.xp, player.XP)
+		}
+		captures, err := rs.dst.ListCaptures(ctx, restored.id)
+		if err != nil {
+			return fmt.Errorf("vérification captures de %s: %w", restored.id, err)
+		}
+		if len(captures) != restored.captures {
+			return fmt.Errorf("vérification joueur %s: %d captures attendues, %d dans la cible", restored.id, restored.captures, len(captures))
+		}
+		if restored.xp != restored.capturePoints {
+			rs.report.XPMismatches = append(rs.report.XPMismatches, XPMismatch{
+				PlayerID:      restored.id,
+				Name:          restored.name,
+				XP:            restored.xp,
+				CapturePoints: restored.capturePoints,
+			})
+		}
+	}
+
+	var locked []string
+	for _, restored := range rs.players {
+		if restored.locked {
+			locked = append(locked, fmt.Sprintf("%s (%s -> %s)", restored.name, restored.oldID, restored.id))
+		}
+	}
+	if len(locked) > 0 {
+		return fmt.Errorf("%w: %d joueur(s) sans jeton valable: %s ; définir auth.secret (WORDMON_AUTH_SECRET) pour en émettre de nouveaux",
+			ErrTokensLost, len(locked), strings.Join(locked, ", "))
+	}
+	return nil
+}
+
+// checkEmpty refuse une cible qui contient déjà des joueurs ou des mots
+func checkEmpty(ctx context.Context, dst store.Store) error {
+	players, err := dst.List(ctx, 1)
+	if err != nil {
+		return fmt.Errorf("erreur lecture cible: %w", err)
+	}
+	words, err := dst.ListWords(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lecture cible: %w", err)
+	}
+	if len(players) > 0 || len(words) > 0 {
+		return ErrNotEmpty
+	}
+	return nil
+}
+
+// ImportSnapshot importe un data/snapshot.json dans dst, qui doit être vide.
+// Les clés d'inventaire sont des IDs ou des textes de mots du catalogue
+// words, complété des mots retirés du snapshot (qui restent retirés, avec
+// l'historique du catalogue) ; les captures sont datées de la sauvegarde du
+// snapshot. Les jetons
+// suivent leur joueur s'il garde son ID ; sinon, comme pour Restore, issuer
+// en émet un nouveau.
+func ImportSnapshot(ctx context.Context, dst store.Store, snapshot *config.GameSnapshot, words []core.Word, issuer *auth.Issuer) (*Report, error) {
+	if err := checkEmpty(ctx, dst); err != nil {
+		return nil, err
+	}
+
+	rs := newRestorer(dst, issuer)
+	rs.report.Header = Header{Format: "snapshot", Version: 1, Source: "snapshot"}
+	capturedAt, err := time.Parse(time.RFC3339, snapshot.UpdatedAt)
+	if err != nil {
+		capturedAt = time.Now().UTC()
+	}
+	rs.report.Header.CreatedAt = capturedAt
+
+	retired := make(map[string]bool, len(snapshot.RetiredWords))
+	for _, word := range snapshot.RetiredWords {
+		retired[word.ID] = true
+	}
+	catalog := make([]store.CatalogWord, 0, len(words)+len(snapshot.RetiredWords))
+	inConfig := make(map[string]bool, len(words))
+	for _, word := range words {
+		inConfig[word.ID] = true
+		catalog = append(catalog, store.CatalogWord{Word: word, Retired: retired[word.ID]})
+	}
+	for _, word := range snapshot.RetiredWords {
+		if !inConfig[word.ID] {
+			catalog = append(catalog, store.CatalogWord{
+				Word:    core.Word{ID: word.ID, Text: word.Text, Rarity: core.Rarity(word.Rarity), Points: word.Points},
+				Retired: true,
+			})
+		}
+	}
+
+	byKey := map[string]string{}
+	for _, word := range catalog {
+		byKey[word.Text] = word.ID
+		rs.points[word.ID] = word.Points
+	}
+	for _, word := range catalog {
+		byKey[word.ID] = word.ID
+	}
+	rs.words = catalog
+	for _, v := range snapshot.CatalogVersions {
+		rs.versions = append(rs.versions, store.CatalogVersion(v))
+	}
+	if err := rs.seed(ctx); err != nil {
+		return rs.report, err
+	}
+
+	for _, p := range snapshot.Players {
+		level := p.Level
+		if level <= 0 {
+			level = core.LevelFromXP(p.XP)
+		}
+		if err := rs.restorePlayer(ctx, core.Player{ID: p.ID, Name: p.Name, XP: p.XP, Level: level, Team: p.Team}); err != nil {
+			return rs.report, fmt.Errorf("erreur joueur %s: %w", p.ID, err)
+		}
+		for _, token := range p.Tokens {
+			record := tokenRecord{ID: token.ID, PlayerID: p.ID, Hash: token.Hash, CreatedAt: token.CreatedAt, RevokedAt: token.RevokedAt}
+			if err := rs.restoreToken(ctx, record); err != nil {
+				return rs.report, fmt.Errorf("erreur jeton %s de %s: %w", token.ID, p.ID, err)
+			}
+		}
+
+		keys := make([]string, 0, len(p.Inventory))
+		for key := range p.Inventory {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			wordID, known := byKey[key]
+			if !known {
+				rs.report.UnknownWords++
+				continue
+			}
+			for range p.Inventory[key] {
+				if err := rs.restoreCapture(ctx, p.ID, store.Capture{WordID: wordID, CapturedAt: capturedAt}); err != nil {
+					return rs.report, fmt.Errorf("erreur capture %s de %s: %w", key, p.ID, err)
+				}
+			}
+		}
+	}
+
+	if err := rs.finish(ctx); err != nil {
+		return rs.report, err
+	}
+	return rs.report, nil
+}
@@ -0,0 +1,166 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+// Snapshotter sauvegarde les joueurs d'un store (backend memory) dans un
+// snapshot JSON au format de config.GameSnapshot, en conservant les
+// générations précédentes pour revenir en arrière
+type Snapshotter struct {
+	store store.Store
+	path  string
+	keep  int
+	mu    sync.Mutex // une sauvegarde à la fois (ticker et arrêt)
+}
+
+// NewSnapshotter crée un Snapshotter écrivant dans path et gardant keep
+// générations précédentes
+func NewSnapshotter(s store.Store, path string, keep int) *Snapshotter {
+	return &Snapshotter{store: s, path: path, keep: keep}
+}
+
+// resetter store vidé entre deux tentatives d'import (MemoryStore)
+type resetter interface {
+	Reset()
+}
+
+// Load restaure au démarrage le snapshot le plus récent importable (courant,
+// puis path.1, path.2, ...) dans le store, qui doit être vide. Un import qui
+// échoue en cours de route vide le store avant la génération suivante. Sans
+// snapshot, seul le catalogue words est écrit.
+func (s *Snapshotter) Load(ctx context.Context, words []core.Word) (*Report, error) {
+	for n := 0; n <= s.keep; n++ {
+		path := config.SnapshotGeneration(s.path, n)
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		snapshot, err := config.LoadSnapshot(path)
+		if err != nil {
+			log.Printf("[snapshot] %s illisible, génération précédente: %v", path, err)
+			continue
+		}
+		report, err := ImportSnapshot(ctx, s.store, snapshot, words, nil)
+		if err == nil {
+			return report, nil
+		}
+		r, ok := s.store.(resetter)
+		if !ok {
+			return report, err
+		}
+		log.Printf("[snapshot] %s non importable, génération précédente: %v", path, err)
+		r.Reset()
+	}
+	return ImportSnapshot(ctx, s.store, &config.GameSnapshot{}, words, nil)
+}
+
+// Save écrit un nouveau snapshot de tous les joueurs et de leur inventaire,
+// avec les mots retirés et l'historique du catalogue
+func (s *Snapshotter) Save(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	players, err := Players(ctx, s.store)
+	if err != nil {
+		return err
+	}
+	snapshot := config.GameSnapshot{Players: players}
+
+	words, err := s.store.ListCatalog(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lecture catalogue: %w", err)
+	}
+	for _, word := range words {
+		if word.Retired {
+			snapshot.RetiredWords = append(snapshot.RetiredWords, config.WordSnapshot{
+				ID: word.ID, Text: word.Text, Rarity: string(word.Rarity), Points: word.Points,
+			})
+		}
+	}
+	versions, err := s.store.ListCatalogVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lecture versions catalogue: %w", err)
+	}
+	for _, v := range versions {
+		snapshot.CatalogVersions = append(snapshot.CatalogVersions, config.CatalogVersionSnapshot(v))
+	}
+	return config.SaveSnapshotGenerations(snapshot, s.path, s.keep)
+}
+
+// Start sauvegarde toutes les interval jusqu'à l'annulation de ctx ; la
+// sauvegarde finale de l'arrêt reste à la charge de l'appelant
+func (s *Snapshotter) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.Save(ctx); err != nil {
+					log.Printf("[snapshot] erreur sauvegarde: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Players lit tous les joueurs de src avec leur inventaire (clé : ID du mot)
+// et l'empreinte de leurs jetons
+func Players(ctx context.Context, src store.Store) ([]config.PlayerSnapshot, error) {
+	players, err := src.List(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture joueurs: %w", err)
+	}
+
+	snapshots := make([]config.PlayerSnapshot, 0, len(players))
+	for _, p := range players {
+		player, err := src.Get(ctx, p.ID)
+		if errors.Is(err, store.ErrNotFound) {
+			continue // supprimé entre-temps
+		}
+		if err != nil {
+			return nil, fmt.Errorf("erreur lecture joueur %s: %w", p.ID, err)
+		}
+		inventory := make(map[string]int, len(player.Inventory))
+		for wordID, count := range player.Inventory {
+			if count > 0 {
+				inventory[wordID] = count
+			}
+		}
+		tokens, err := src.ListTokens(ctx, player.ID)
+		if err != nil {
+			return nil, fmt.Errorf("erreur lecture jetons de %s: %w", player.ID, err)
+		}
+		tokenSnapshots := make([]config.TokenSnapshot, 0, len(tokens))
+		for _, token := range tokens {
+			tokenSnapshots = append(tokenSnapshots, config.TokenSnapshot{
+				ID:        token.ID,
+				Hash:      token.Hash,
+				CreatedAt: token.CreatedAt,
+				RevokedAt: token.RevokedAt,
+			})
+		}
+		snapshots = append(snapshots, config.PlayerSnapshot{
+			ID:        player.ID,
+			Name:      player.Name,
+			XP:        player.XP,
+			Level:     player.Level,
+			Team:      player.Team,
+			Inventory: inventory,
+			Tokens:    tokenSnapshots,
+		})
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,135 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+func TestSnapshotterRotatesAndReloads(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	src := fillMemory(t)
+	snapshotter := NewSnapshotter(src, path, 2)
+
+	for range 4 {
+		if err := snapshotter.Save(ctx); err != nil {
+			t.Fatalf("sauvegarde: %v", err)
+		}
+	}
+	for n := range 3 {
+		if _, err := os.Stat(config.SnapshotGeneration(path, n)); err != nil {
+			t.Errorf("génération %d absente: %v", n, err)
+		}
+	}
+	if _, err := os.Stat(config.SnapshotGeneration(path, 3)); !os.IsNotExist(err) {
+		t.Errorf("2 générations précédentes au plus: %v", err)
+	}
+
+	// Le snapshot courant est corrompu : la génération précédente est reprise
+	os.WriteFile(path, []byte("{"), 0644)
+	dst := store.NewMemoryStore()
+	report, err := NewSnapshotter(dst, path, 2).Load(ctx, testWords)
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+	if report.Counts.Players != 2 || report.Counts.Captures != 3 {
+		t.Errorf("bilan inattendu: %+v", report)
+	}
+	alice, err := dst.Get(ctx, "p1")
+	if err != nil || alice.XP != 55 || alice.Team != "rouge" || alice.Inventory["l_1"] != 1 {
+		t.Errorf("Alice mal restaurée: %+v (%v)", alice, err)
+	}
+}
+
+func TestSnapshotterSkipsGenerationFailingImport(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := NewSnapshotter(fillMemory(t), path, 2).Save(ctx); err != nil {
+		t.Fatalf("sauvegarde: %v", err)
+	}
+
+	// Le snapshot courant est lisible mais échoue après un premier joueur importé
+	broken := config.GameSnapshot{Players: []config.PlayerSnapshot{
+		{ID: "p7", Name: "Zoé", XP: 10},
+		{ID: "p8", Name: "Zoé", XP: 20},
+	}}
+	if err := config.SaveSnapshotGenerations(broken, path, 2); err != nil {
+		t.Fatalf("sauvegarde: %v", err)
+	}
+
+	dst := store.NewMemoryStore()
+	report, err := NewSnapshotter(dst, path, 2).Load(ctx, testWords)
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+	if report.Counts.Players != 2 {
+		t.Errorf("génération précédente attendue: %+v", report)
+	}
+	if _, err := dst.Get(ctx, "p7"); err == nil {
+		t.Error("joueur de l'import échoué resté dans le store")
+	}
+	if players, _ := dst.List(ctx, 0); len(players) != 2 {
+		t.Errorf("2 joueurs attendus, obtenu %+v", players)
+	}
+}
+
+func TestSnapshotGenerationsKeepPrevious(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	for _, name := range []string{"Alice", "Bob"} {
+		if err := config.SaveSnapshotGenerations(config.GameSnapshot{Players: []config.PlayerSnapshot{{ID: "p1", Name: name}}}, path, 2); err != nil {
+			t.Fatalf("sauvegarde: %v", err)
+		}
+	}
+
+	for n, want := range []string{"Bob", "Alice"} {
+		snapshot, err := config.LoadSnapshot(config.SnapshotGeneration(path, n))
+		if err != nil || len(snapshot.Players) != 1 || snapshot.Players[0].Name != want {
+			t.Errorf("génération %d: %s attendu, obtenu %+v (%v)", n, want, snapshot, err)
+		}
+	}
+	if _, err := os.Stat(path + ".1.tmp"); !os.IsNotExist(err) {
+		t.Errorf("fichier temporaire de copie restant: %v", err)
+	}
+}
+
+func TestSnapshotterLoadWithoutSnapshot(t *testing.T) {
+	ctx := t.Context()
+	dst := store.NewMemoryStore()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	if _, err := NewSnapshotter(dst, path, 3).Load(ctx, testWords); err != nil {
+		t.Fatalf("chargement sans snapshot: %v", err)
+	}
+	if words, _ := dst.ListWords(ctx); len(words) != len(testWords) {
+		t.Errorf("catalogue attendu, obtenu %d mots", len(words))
+	}
+}
+
+func TestSnapshotterKeepsTokens(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	secret := []byte("secret-de-test")
+	src := fillMemory(t)
+	raw, _, err := auth.NewIssuer(secret, src).Issue(ctx, "p2")
+	if err != nil {
+		t.Fatalf("émission: %v", err)
+	}
+	if err := NewSnapshotter(src, path, 1).Save(ctx); err != nil {
+		t.Fatalf("sauvegarde: %v", err)
+	}
+
+	// Redémarrage : le jeton émis avant reste valable
+	dst := store.NewMemoryStore()
+	report, err := NewSnapshotter(dst, path, 1).Load(ctx, testWords)
+	if err != nil || report.Counts.Tokens != 2 {
+		t.Fatalf("2 jetons restaurés attendus: %+v (%v)", report, err)
+	}
+	token, err := auth.NewIssuer(secret, dst).Authenticate(ctx, raw)
+	if err != nil || token.PlayerID != "p2" {
+		t.Errorf("authentification après restauration: %+v (%v)", token, err)
+	}
+}
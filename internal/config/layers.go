@@ -0,0 +1,288 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Sources d'une valeur de la config, par priorité croissante
+const (
+	SourceDefault = "défaut"
+	SourceFile    = "fichier"
+	SourceEnv     = "env"
+	SourceFlag    = "flag"
+)
+
+// envAliases anciennes variables d'environnement, toujours reconnues (la
+// variable WORDMON_<SECTION>_<CLÉ> reste prioritaire)
+var envAliases = map[string]string{
+	"spawner.intervalSeconds": "WORDMON_SPAWN_INTERVAL",
+	"server.store":            "WORDMON_STORE",
+	"server.grpcPort":         "WORDMON_GRPC_PORT",
+	"server.dataDir":          "WORDMON_DATA_DIR",
+	"server.snapshotPath":     "WORDMON_SNAPSHOT_PATH",
+	"server.databaseURL":      "DATABASE_URL",
+}
+
+// Overrides réglages passés en ligne de commande, par clé "section.réglage".
+// Implémente flag.Value pour un flag répétable --set section.réglage=valeur.
+type Overrides map[string]string
+
+// String implémente flag.Value
+func (o Overrides) String() string {
+	pairs := make([]string, 0, len(o))
+	for key, value := range o {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set implémente flag.Value
+func (o Overrides) Set(value string) error {
+	key, raw, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return fmt.Errorf("réglage attendu sous la forme section.clé=valeur: %s", value)
+	}
+	o[strings.TrimSpace(key)] = raw
+	return nil
+}
+
+// EnvName variable d'environnement d'un réglage : "spawner.intervalSeconds"
+// donne WORDMON_SPAWNER_INTERVAL_SECONDS
+func EnvName(key string) string {
+	var b strings.Builder
+	b.WriteString("WORDMON")
+	for _, part := range strings.Split(key, ".") {
+		b.WriteByte('_')
+		runes := []rune(part)
+		for i, r := range runes {
+			if i > 0 && unicode.IsUpper(r) {
+				prev := runes[i-1]
+				nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+					b.WriteByte('_')
+				}
+			}
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
+
+// Setting valeur effective d'un réglage et son origine
+type Setting struct {
+	Key    string
+	Value  string
+	Source string
+	Env    string
+}
+
+// Settings liste les réglages effectifs, triés par clé ; les secrets sont masqués
+func (c *GameConfig) Settings() []Setting {
+	values := map[string]string{}
+	flatten("", reflect.ValueOf(*c), values)
+
+	settings := make([]Setting, 0, len(values))
+	for key, value := range values {
+		if secretKeys[key] && value != `""` {
+			value = "***"
+		}
+		source := c.sources[key]
+		if source == "" {
+			source = SourceDefault
+		}
+		settings = append(settings, Setting{Key: key, Value: value, Source: source, Env: EnvName(key)})
+	}
+	sort.Slice(settings, func(i, j int) bool { return settings[i].Key < settings[j].Key })
+	return settings
+}
+
+// Source retourne l'origine de la valeur d'un réglage
+func (c *GameConfig) Source(key string) string {
+	if source, ok := c.sources[key]; ok {
+		return source
+	}
+	return SourceDefault
+}
+
+// PrintSettings affiche la config effective : clé, valeur, origine et
+// variable d'environnement de chaque réglage
+func PrintSettings(w io.Writer, c *GameConfig) {
+	for _, setting := range c.Settings() {
+		fmt.Fprintf(w, "%-36s %-32s %-8s %s\n", setting.Key, setting.Value, setting.Source, setting.Env)
+	}
+}
+
+// settingFields champs feuilles de la config, par clé "section.réglage"
+func settingFields(c *GameConfig) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+	var walk func(prefix string, v reflect.Value)
+	walk = func(prefix string, v reflect.Value) {
+		t := v.Type()
+		for i := range t.NumField() {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			key := joinKey(prefix, yamlName(t.Field(i)))
+			if v.Field(i).Kind() == reflect.Struct {
+				walk(key, v.Field(i))
+				continue
+			}
+			fields[key] = v.Field(i)
+		}
+	}
+	walk("", reflect.ValueOf(c).Elem())
+	return fields
+}
+
+// applyLayers applique l'environnement et les flags à une config déjà
+// remplie par les défauts puis par un fichier dont fileKeys liste les clés,
+// et note l'origine de chaque valeur. Une clé présente dans le fichier
+// l'emporte sur le défaut, même à 0 ou vide.
+func applyLayers(c *GameConfig, fileKeys map[string]bool, flags Overrides) error {
+	values := map[string]string{}
+	flatten("", reflect.ValueOf(*c), values)
+
+	c.sources = make(map[string]string, len(values))
+	for key := range values {
+		if fileKeys[key] {
+			c.sources[key] = SourceFile
+		} else {
+			c.sources[key] = SourceDefault
+		}
+	}
+
+	fields := settingFields(c)
+	for key, field := range fields {
+		name, raw, ok := lookupEnv(key)
+		if !ok {
+			continue
+		}
+		if err := setField(field, key, raw); err != nil {
+			return fmt.Errorf("variable %s: %w", name, err)
+		}
+		c.sources[key] = SourceEnv
+		fmt.Printf("[config] override ENV: %s=%s\n", key, displayValue(key, field))
+	}
+
+	for key, raw := range flags {
+		field, exists := fields[key]
+		if !exists {
+			return fmt.Errorf("réglage inconnu: %s", key)
+		}
+		if err := setField(field, key, raw); err != nil {
+			return fmt.Errorf("flag %s: %w", key, err)
+		}
+		c.sources[key] = SourceFlag
+		fmt.Printf("[config] override flag: %s=%s\n", key, displayValue(key, field))
+	}
+	return nil
+}
+
+// lookupEnv cherche la variable d'un réglage, puis son ancien nom
+func lookupEnv(key string) (string, string, bool) {
+	name := EnvName(key)
+	if raw, ok := os.LookupEnv(name); ok && raw != "" {
+		return name, raw, true
+	}
+	if alias, exists := envAliases[key]; exists {
+		if raw, ok := os.LookupEnv(alias); ok && raw != "" {
+			return alias, raw, true
+		}
+	}
+	return "", "", false
+}
+
+// setField affecte raw au champ d'un réglage. Les réglages en secondes ou en
+// millisecondes (suffixes Seconds et Ms) acceptent aussi une durée ("3s", "1m30s").
+func setField(field reflect.Value, key, raw string) error {
+	raw = strings.TrimSpace(raw)
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int:
+		n, err := parseInt(key, raw)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("%s: nombre attendu, obtenu %q", key, raw)
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("%s: type non pris en charge", key)
+	}
+	return nil
+}
+
+// parseInt lit un entier, ou une durée pour les réglages en secondes ou
+// millisecondes (qui doit alors tomber juste dans l'unité)
+func parseInt(key, raw string) (int, error) {
+	if n, err := strconv.Atoi(raw); err == nil {
+		return n, nil
+	}
+
+	var unit time.Duration
+	switch {
+	case strings.HasSuffix(key, "Seconds"):
+		unit = time.Second
+	case strings.HasSuffix(key, "Ms"):
+		unit = time.Millisecond
+	default:
+		return 0, fmt.Errorf("%s: entier attendu, obtenu %q", key, raw)
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%s: entier ou durée attendu, obtenu %q", key, raw)
+	}
+	if d%unit != 0 {
+		return 0, fmt.Errorf("%s: %s n'est pas un nombre entier de %v", key, raw, unit)
+	}
+	return int(d / unit), nil
+}
+
+// displayValue valeur d'un réglage pour les journaux (secrets masqués)
+func displayValue(key string, field reflect.Value) string {
+	if secretKeys[key] {
+		return "***"
+	}
+	return fmt.Sprint(field.Interface())
+}
+
+// fileKeys clés "section.réglage" présentes dans un document décodé
+func fileKeys(doc map[string]any) map[string]bool {
+	keys := map[string]bool{}
+	var walk func(prefix string, m map[string]any)
+	walk = func(prefix string, m map[string]any) {
+		for name, value := range m {
+			key := joinKey(prefix, name)
+			if nested, ok := value.(map[string]any); ok {
+				walk(key, nested)
+				continue
+			}
+			keys[key] = true
+		}
+	}
+	walk("", doc)
+	return keys
+}
+
+// yamlName nom YAML d'un champ (nom Go à défaut)
+func yamlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
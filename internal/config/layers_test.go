@@ -0,0 +1,63 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadGameConfigLayers(t *testing.T) {
+	files := copyConfigs(t)
+	editFile(t, files.Game, "compactIntervalSeconds: 300", "compactIntervalSeconds: 0") // 0 explicite : compaction désactivée
+	editFile(t, files.Game, "snapshotKeep: 3", "# snapshotKeep: 3")                     // absente : défaut
+
+	t.Setenv("WORDMON_SPAWN_INTERVAL", "3s")
+	t.Setenv("WORDMON_BATTLE_WINDOW_MS", "1.5s")
+	t.Setenv("WORDMON_SERVER_PORT", "9090")
+	t.Setenv("WORDMON_SERVER_STORE", "file")
+	t.Setenv("WORDMON_STORE", "sql") // ancien nom : la variable canonique l'emporte
+
+	gameConfig, err := LoadGameConfigWith(files.Game, Overrides{"server.port": "7070"})
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+
+	for _, tc := range []struct {
+		key, source string
+		got, want   any
+	}{
+		{"spawner.intervalSeconds", SourceEnv, gameConfig.Spawner.IntervalSeconds, 3},
+		{"battle.windowMs", SourceEnv, gameConfig.Battle.WindowMs, 1500},
+		{"server.port", SourceFlag, gameConfig.Server.Port, "7070"},
+		{"server.store", SourceEnv, gameConfig.Server.Store, "file"},
+		{"server.compactIntervalSeconds", SourceFile, gameConfig.Server.CompactIntervalSeconds, 0},
+		{"server.snapshotKeep", SourceDefault, gameConfig.Server.SnapshotKeep, 3},
+		{"server.grpcPort", SourceFile, gameConfig.Server.GRPCPort, "9090"},
+		{"rarityWeights.Common", SourceFile, gameConfig.RarityWeights.Common, 80},
+	} {
+		if tc.got != tc.want || gameConfig.Source(tc.key) != tc.source {
+			t.Errorf("%s = %v (%s), attendu %v (%s)", tc.key, tc.got, gameConfig.Source(tc.key), tc.want, tc.source)
+		}
+	}
+
+	t.Setenv("WORDMON_SPAWNER_INTERVAL_SECONDS", "1500ms")
+	if _, err := LoadGameConfigWith(files.Game, nil); err == nil || !strings.Contains(err.Error(), "WORDMON_SPAWNER_INTERVAL_SECONDS") {
+		t.Errorf("durée non entière en secondes: erreur attendue, obtenu %v", err)
+	}
+	t.Setenv("WORDMON_SPAWNER_INTERVAL_SECONDS", "")
+	if _, err := LoadGameConfigWith(files.Game, Overrides{"spawner.inconnu": "1"}); err == nil {
+		t.Error("réglage inconnu: erreur attendue")
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	for key, want := range map[string]string{
+		"spawner.intervalSeconds":  "WORDMON_SPAWNER_INTERVAL_SECONDS",
+		"server.databaseURL":       "WORDMON_SERVER_DATABASE_URL",
+		"xpRewards.Legendary":      "WORDMON_XP_REWARDS_LEGENDARY",
+		"battle.wrongAnswerXPCost": "WORDMON_BATTLE_WRONG_ANSWER_XP_COST",
+	} {
+		if got := EnvName(key); got != want {
+			t.Errorf("EnvName(%q) = %s, attendu %s", key, got, want)
+		}
+	}
+}
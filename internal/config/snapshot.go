@@ -10,25 +10,63 @@ import (
 
 // PlayerSnapshot représente un joueur dans le snapshot
 type PlayerSnapshot struct {
-	ID        string         `json:"id"`
-	Name      string         `json:"name"`
-	XP        int            `json:"xp"`
-	Level     int            `json:"level"`
-	Inventory map[string]int `json:"inventory"`
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	XP        int             `json:"xp"`
+	Level     int             `json:"level"`
+	Team      string          `json:"team,omitempty"`
+	Inventory map[string]int  `json:"inventory"`
+	Tokens    []TokenSnapshot `json:"tokens,omitempty"`
+}
+
+// TokenSnapshot jeton d'API d'un joueur (empreinte seulement, jamais le jeton)
+type TokenSnapshot struct {
+	ID        string     `json:"id"`
+	Hash      string     `json:"hash"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// WordSnapshot mot retiré du catalogue, gardé pour les captures existantes
+type WordSnapshot struct {
+	ID     string `json:"id"`
+	Text   string `json:"text"`
+	Rarity string `json:"rarity"`
+	Points int    `json:"points"`
+}
+
+// CatalogVersionSnapshot version enregistrée du catalogue
+type CatalogVersionSnapshot struct {
+	Version  int       `json:"version"`
+	Checksum string    `json:"checksum"`
+	Added    int       `json:"added"`
+	Updated  int       `json:"updated"`
+	Retired  int       `json:"retired"`
+	Restored int       `json:"restored"`
+	SyncedAt time.Time `json:"syncedAt"`
 }
 
 // GameSnapshot représente l'état complet du jeu
 type GameSnapshot struct {
-	UpdatedAt string           `json:"updatedAt"`
-	Players   []PlayerSnapshot `json:"players"`
+	UpdatedAt       string                   `json:"updatedAt"`
+	Players         []PlayerSnapshot         `json:"players"`
+	RetiredWords    []WordSnapshot           `json:"retiredWords,omitempty"`
+	CatalogVersions []CatalogVersionSnapshot `json:"catalogVersions,omitempty"`
 }
 
 // SaveSnapshot sauvegarde l'état des joueurs dans un fichier JSON
 func SaveSnapshot(players []PlayerSnapshot, filePath string) error {
-	snapshot := GameSnapshot{
-		UpdatedAt: time.Now().UTC().Format(time.RFC3339),
-		Players:   players,
-	}
+	return SaveSnapshotGenerations(GameSnapshot{Players: players}, filePath, 0)
+}
+
+// SaveSnapshotGenerations sauvegarde snapshot (daté de maintenant) en
+// conservant les keep snapshots précédents (filePath.1 le plus récent,
+// filePath.keep le plus ancien). Le snapshot courant est copié, et non
+// déplacé, vers filePath.1 avant d'être remplacé : filePath existe à tout
+// instant.
+func SaveSnapshotGenerations(snapshot GameSnapshot, filePath string, keep int) error {
+	snapshot.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	players := snapshot.Players
 
 	// Créer le répertoire data s'il n'existe pas
 	dir := filepath.Dir(filePath)
@@ -50,6 +88,23 @@ func SaveSnapshot(players []PlayerSnapshot, filePath string) error {
 		return fmt.Errorf("erreur écriture fichier temporaire: %w", err)
 	}
 
+	// Décaler les générations : filePath.(keep-1) -> filePath.keep, ..., filePath.1 -> filePath.2
+	for i := keep; i >= 2; i-- {
+		from := SnapshotGeneration(filePath, i-1)
+		if err := os.Rename(from, SnapshotGeneration(filePath, i)); err != nil && !os.IsNotExist(err) {
+			os.Remove(tempFile)
+			return fmt.Errorf("erreur rotation snapshot %s: %w", from, err)
+		}
+	}
+
+	// Copier le snapshot courant vers filePath.1 ; il reste en place jusqu'au rename
+	if keep >= 1 {
+		if err := copySnapshot(filePath, SnapshotGeneration(filePath, 1)); err != nil {
+			os.Remove(tempFile)
+			return err
+		}
+	}
+
 	// Renommer pour remplacer atomiquement
 	if err := os.Rename(tempFile, filePath); err != nil {
 		// Nettoyer le fichier temporaire en cas d'erreur
@@ -61,6 +116,34 @@ func SaveSnapshot(players []PlayerSnapshot, filePath string) error {
 	return nil
 }
 
+// copySnapshot copie from vers to (fichier temporaire puis rename) ; sans
+// effet si from n'existe pas
+func copySnapshot(from, to string) error {
+	data, err := os.ReadFile(from)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("erreur lecture snapshot %s: %w", from, err)
+	}
+	if err := os.WriteFile(to+".tmp", data, 0644); err != nil {
+		return fmt.Errorf("erreur copie snapshot %s: %w", from, err)
+	}
+	if err := os.Rename(to+".tmp", to); err != nil {
+		os.Remove(to + ".tmp")
+		return fmt.Errorf("erreur copie snapshot %s: %w", from, err)
+	}
+	return nil
+}
+
+// SnapshotGeneration chemin de la génération n d'un snapshot (0 : le courant)
+func SnapshotGeneration(filePath string, n int) string {
+	if n == 0 {
+		return filePath
+	}
+	return fmt.Sprintf("%s.%d", filePath, n)
+}
+
 // LoadSnapshot charge l'état des joueurs depuis un fichier JSON
 func LoadSnapshot(filePath string) (*GameSnapshot, error) {
 	absPath, err := filepath.Abs(filePath)
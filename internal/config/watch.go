@@ -0,0 +1,310 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Files chemins des fichiers de configuration rechargés ensemble (les
+// variables WORDMON_*_PATH restent prioritaires)
+type Files struct {
+	Game       string
+	Words      string
+	Challenges string
+}
+
+// DefaultFiles chemins des fichiers de configuration du serveur
+var DefaultFiles = Files{
+	Game:       "configs/game.yaml",
+	Words:      "configs/words.json",
+	Challenges: "configs/challenges.yaml",
+}
+
+// Bundle configuration complète : les trois fichiers chargés et validés ensemble
+type Bundle struct {
+	Game       *GameConfig
+	Words      *WordsConfig
+	Challenges *ChallengesConfig
+}
+
+// LoadBundle charge les trois fichiers, avec les flags appliqués à game.yaml ;
+// erreur si l'un d'eux est invalide
+func LoadBundle(files Files, flags Overrides) (*Bundle, error) {
+	game, err := LoadGameConfigWith(files.Game, flags)
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement config: %w", err)
+	}
+	words, err := LoadWordsConfig(files.Words)
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement words: %w", err)
+	}
+	challenges, err := LoadChallengesConfig(files.Challenges)
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement challenges: %w", err)
+	}
+	return &Bundle{Game: game, Words: words, Challenges: challenges}, nil
+}
+
+// HotSections sections de game.yaml appliquées sans redémarrage ; words.json
+// et challenges.yaml le sont entièrement
+var HotSections = []string{"rarityWeights", "xpRewards", "spawner", "battle"}
+
+// secretKeys réglages dont la valeur n'apparaît jamais dans les journaux
+var secretKeys = map[string]bool{
+	"server.databaseURL": true,
+	"admin.token":        true,
+	"auth.secret":        true,
+}
+
+// DiffBundles décrit, une ligne par changement, l'écart entre deux
+// configurations. Les réglages de game.yaml hors HotSections sont marqués
+// comme appliqués au prochain redémarrage.
+func DiffBundles(old, next *Bundle) []string {
+	var changes []string
+
+	before, after := map[string]string{}, map[string]string{}
+	flatten("", reflect.ValueOf(*old.Game), before)
+	flatten("", reflect.ValueOf(*next.Game), after)
+	for _, key := range changedKeys(before, after) {
+		line := fmt.Sprintf("%s: %s -> %s", key, before[key], after[key])
+		if secretKeys[key] {
+			line = key + ": modifié"
+		}
+		if !isHot(key) {
+			line += " (au prochain redémarrage)"
+		}
+		changes = append(changes, line)
+	}
+
+	changes = append(changes, diffWords(old.Words, next.Words)...)
+
+	before, after = map[string]string{}, map[string]string{}
+	flatten("challenges", reflect.ValueOf(*old.Challenges), before)
+	flatten("challenges", reflect.ValueOf(*next.Challenges), after)
+	for _, key := range changedKeys(before, after) {
+		changes = append(changes, fmt.Sprintf("%s: %s -> %s", key, before[key], after[key]))
+	}
+	return changes
+}
+
+// diffWords décrit les mots ajoutés, retirés ou modifiés (par ID)
+func diffWords(old, next *WordsConfig) []string {
+	before := make(map[string]WordEntry, len(old.Words))
+	for _, word := range old.Words {
+		before[word.ID] = word
+	}
+
+	var changes []string
+	seen := make(map[string]bool, len(next.Words))
+	for _, word := range next.Words {
+		seen[word.ID] = true
+		previous, exists := before[word.ID]
+		switch {
+		case !exists:
+			changes = append(changes, fmt.Sprintf("words: + %s %q (%s)", word.ID, word.Text, word.Rarity))
+		case previous != word:
+			changes = append(changes, fmt.Sprintf("words: ~ %s %q (%s) -> %q (%s)",
+				word.ID, previous.Text, previous.Rarity, word.Text, word.Rarity))
+		}
+	}
+	for _, word := range old.Words {
+		if !seen[word.ID] {
+			changes = append(changes, fmt.Sprintf("words: - %s %q", word.ID, word.Text))
+		}
+	}
+	return changes
+}
+
+// flatten aplatit une config en clés "section.réglage" (noms YAML)
+func flatten(prefix string, v reflect.Value, out map[string]string) {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			if t.Field(i).IsExported() {
+				flatten(joinKey(prefix, yamlName(t.Field(i))), v.Field(i), out)
+			}
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			flatten(joinKey(prefix, fmt.Sprint(key.Interface())), v.MapIndex(key), out)
+		}
+	case reflect.String:
+		out[prefix] = fmt.Sprintf("%q", v.String())
+	default:
+		out[prefix] = fmt.Sprint(v.Interface())
+	}
+}
+
+func joinKey(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// changedKeys clés ajoutées, retirées ou modifiées, triées
+func changedKeys(before, after map[string]string) []string {
+	var keys []string
+	for key, value := range after {
+		if previous, exists := before[key]; !exists || previous != value {
+			keys = append(keys, key)
+		}
+	}
+	for key := range before {
+		if _, exists := after[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isHot(key string) bool {
+	section, _, _ := strings.Cut(key, ".")
+	for _, hot := range HotSections {
+		if section == hot {
+			return true
+		}
+	}
+	return false
+}
+
+// Watcher recharge la configuration quand un fichier change (surveillance
+// périodique) ou sur signal (SIGHUP). Une config invalide, ou que apply
+// refuse, est ignorée : la config courante reste en place.
+type Watcher struct {
+	files Files
+	flags Overrides
+	apply func(next *Bundle) error
+
+	mu      sync.Mutex
+	current *Bundle
+	stamps  map[string]fileStamp
+}
+
+// fileStamp état d'un fichier surveillé
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewWatcher crée un watcher depuis la config chargée au démarrage ; les
+// flags restent appliqués à chaque rechargement. apply reçoit chaque nouvelle
+// config validée ; s'il échoue, elle n'est pas retenue.
+func NewWatcher(files Files, flags Overrides, current *Bundle, apply func(next *Bundle) error) *Watcher {
+	w := &Watcher{files: files, flags: flags, apply: apply, current: current}
+	w.stamps = w.stat()
+	return w
+}
+
+// Current retourne la dernière config appliquée
+func (w *Watcher) Current() *Bundle {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.current
+}
+
+// Reload recharge et valide les trois fichiers, puis applique la nouvelle
+// config si elle diffère de la courante ; retourne les changements appliqués
+func (w *Watcher) Reload() ([]string, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Relevé avant lecture : une écriture pendant le chargement sera revue
+	w.stamps = w.stat()
+	next, err := LoadBundle(w.files, w.flags)
+	if err != nil {
+		return nil, err
+	}
+	changes := DiffBundles(w.current, next)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	if err := w.apply(next); err != nil {
+		return nil, fmt.Errorf("erreur application config: %w", err)
+	}
+	w.current = next
+	return changes, nil
+}
+
+// Start surveille les fichiers toutes les interval (0 : jamais) et recharge
+// à chaque signal reçu sur signals, jusqu'à l'annulation du contexte
+func (w *Watcher) Start(ctx context.Context, interval time.Duration, signals <-chan os.Signal) {
+	go func() {
+		var tick <-chan time.Time
+		if interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				fmt.Println("[config] signal reçu : rechargement de la config")
+				w.reload()
+			case <-tick:
+				if w.modified() {
+					fmt.Println("[config] fichier modifié : rechargement de la config")
+					w.reload()
+				}
+			}
+		}
+	}()
+}
+
+// reload recharge et journalise l'issue
+func (w *Watcher) reload() {
+	changes, err := w.Reload()
+	if err != nil {
+		fmt.Printf("[config] rechargement refusé, config actuelle conservée: %v\n", err)
+		return
+	}
+	if len(changes) == 0 {
+		fmt.Println("[config] rechargement: aucun changement")
+		return
+	}
+	for _, change := range changes {
+		fmt.Printf("[config] %s\n", change)
+	}
+	fmt.Printf("[config] rechargement appliqué (%d changement(s))\n", len(changes))
+}
+
+// modified indique si un fichier surveillé a changé depuis le dernier relevé
+func (w *Watcher) modified() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return !reflect.DeepEqual(w.stat(), w.stamps)
+}
+
+// stat relève l'état des trois fichiers (un fichier absent n'a pas d'entrée)
+func (w *Watcher) stat() map[string]fileStamp {
+	stamps := make(map[string]fileStamp, 3)
+	for _, path := range []string{
+		envPath(w.files.Game, "WORDMON_CONFIG_PATH"),
+		envPath(w.files.Words, "WORDMON_WORDS_PATH"),
+		envPath(w.files.Challenges, "WORDMON_CHALLENGES_PATH"),
+	} {
+		if info, err := os.Stat(path); err == nil {
+			stamps[path] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return stamps
+}
+
+// envPath applique l'override de chemin par variable d'environnement
+func envPath(path, env string) string {
+	if override := os.Getenv(env); override != "" {
+		return override
+	}
+	return path
+}
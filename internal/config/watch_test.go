@@ -0,0 +1,94 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// copyConfigs copie les configs du dépôt dans un répertoire temporaire
+func copyConfigs(t *testing.T) Files {
+	t.Helper()
+	dir := t.TempDir()
+	files := Files{
+		Game:       filepath.Join(dir, "game.yaml"),
+		Words:      filepath.Join(dir, "words.json"),
+		Challenges: filepath.Join(dir, "challenges.yaml"),
+	}
+	for source, target := range map[string]string{
+		"../../configs/game.yaml":       files.Game,
+		"../../configs/words.json":      files.Words,
+		"../../configs/challenges.yaml": files.Challenges,
+	} {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			t.Fatalf("lecture %s: %v", source, err)
+		}
+		if err := os.WriteFile(target, data, 0644); err != nil {
+			t.Fatalf("écriture %s: %v", target, err)
+		}
+	}
+	return files
+}
+
+// editFile remplace old par new dans path
+func editFile(t *testing.T, path, old, new string) {
+	t.Helper()
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), old) {
+		t.Fatalf("%q absent de %s", old, path)
+	}
+	os.WriteFile(path, []byte(strings.Replace(string(data), old, new, 1)), 0644)
+}
+
+func TestWatcherReload(t *testing.T) {
+	files := copyConfigs(t)
+	initial, err := LoadBundle(files, nil)
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+
+	var applied []*Bundle
+	var refuse error
+	watcher := NewWatcher(files, nil, initial, func(next *Bundle) error {
+		if refuse != nil {
+			return refuse
+		}
+		applied = append(applied, next)
+		return nil
+	})
+
+	if changes, err := watcher.Reload(); err != nil || len(changes) != 0 || len(applied) != 0 {
+		t.Fatalf("aucun changement attendu: %v (%v)", changes, err)
+	}
+
+	editFile(t, files.Game, "Common: 5", "Common: 12")
+	editFile(t, files.Game, `port: "8080"`, `port: "8081"`)
+	if !watcher.modified() {
+		t.Error("modification du fichier non détectée")
+	}
+	changes, err := watcher.Reload()
+	if err != nil || len(applied) != 1 || watcher.Current().Game.XPRewards.Common != 12 {
+		t.Fatalf("nouvelle config appliquée attendue: %v (%v)", changes, err)
+	}
+	for _, want := range []string{"xpRewards.Common: 5 -> 12", `server.port: "8080" -> "8081" (au prochain redémarrage)`} {
+		if !slices.Contains(changes, want) {
+			t.Errorf("changement %q absent de %v", want, changes)
+		}
+	}
+
+	// Config invalide ou refusée : la config courante reste en place
+	editFile(t, files.Game, "Common: 80", "Common: 90")
+	if _, err := watcher.Reload(); err == nil || len(applied) != 1 {
+		t.Errorf("somme des poids invalide: erreur attendue, obtenu %v", err)
+	}
+	editFile(t, files.Game, "Common: 90", "Common: 80")
+	editFile(t, files.Words, `"text": "chat"`, `"text": "chats"`)
+	refuse = errors.New("store indisponible")
+	if _, err := watcher.Reload(); !errors.Is(err, refuse) || watcher.Current().Words.Words[0].Text != "chat" {
+		t.Errorf("config refusée par apply: erreur attendue et words inchangé, obtenu %v", err)
+	}
+}
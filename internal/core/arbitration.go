@@ -0,0 +1,321 @@
+// Package core contient les types et fonctions principaux du jeu WordMon.
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ArbitrationPolicy identifie une politique d'arbitrage des combats.
+type ArbitrationPolicy string
+
+// Politiques d'arbitrage disponibles.
+const (
+	FirstAttemptWins   ArbitrationPolicy = "first-attempt-wins"
+	FirstCorrectWins   ArbitrationPolicy = "first-correct-wins"
+	FastestCorrectWins ArbitrationPolicy = "fastest-correct-wins"
+	SharedCapture      ArbitrationPolicy = "shared-capture"
+)
+
+// Raisons possibles d'un verdict.
+const (
+	VerdictCaptured     = "captured"
+	VerdictWrongAttempt = "wrong attempt"
+	VerdictTimeout      = "timeout"
+)
+
+// ArbitrationConfig décrit la politique d'arbitrage et sa fenêtre de collecte.
+type ArbitrationConfig struct {
+	Policy ArbitrationPolicy
+	Window time.Duration
+}
+
+// DefaultArbitration retourne la politique historique : la première tentative décide.
+func DefaultArbitration() ArbitrationConfig {
+	return ArbitrationConfig{Policy: FirstAttemptWins}
+}
+
+// Judgement représente une tentative jugée par le défi du WordMon.
+type Judgement struct {
+	Attempt Attempt
+	Correct bool
+	Err     error
+	At      time.Time
+}
+
+// Verdict représente l'issue arbitrée d'un combat.
+type Verdict struct {
+	Winners []Judgement
+	Reason  string
+}
+
+// HasWinner indique si le joueur fait partie des gagnants du verdict.
+func (v Verdict) HasWinner(playerID string) bool {
+	for _, w := range v.Winners {
+		if w.Attempt.PlayerID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// ArbiterDecision indique l'effet d'une tentative sur le combat.
+type ArbiterDecision int
+
+// Décisions possibles d'un arbitre après une tentative.
+const (
+	ArbiterPending ArbiterDecision = iota
+	ArbiterOpenWindow
+	ArbiterDecided
+)
+
+// Arbiter applique une politique d'arbitrage aux tentatives d'un combat.
+// Une instance est créée pour chaque combat.
+type Arbiter interface {
+	// Offer enregistre une tentative jugée et indique si le combat est décidé
+	// ou si une fenêtre de collecte doit s'ouvrir.
+	Offer(j Judgement) ArbiterDecision
+	// Verdict retourne l'issue du combat à sa clôture.
+	Verdict() Verdict
+}
+
+// ArbiterFactory crée un arbitre pour un nouveau combat.
+type ArbiterFactory func() Arbiter
+
+var (
+	arbitersMu sync.RWMutex
+	arbiters   = map[ArbitrationPolicy]ArbiterFactory{
+		FirstAttemptWins:   func() Arbiter { return &firstAttemptArbiter{} },
+		FirstCorrectWins:   func() Arbiter { return &firstCorrectArbiter{} },
+		FastestCorrectWins: func() Arbiter { return &fastestCorrectArbiter{} },
+		SharedCapture:      func() Arbiter { return &sharedCaptureArbiter{seen: make(map[string]bool)} },
+	}
+)
+
+// RegisterArbitrationPolicy ajoute (ou remplace) une politique d'arbitrage.
+func RegisterArbitrationPolicy(policy ArbitrationPolicy, factory ArbiterFactory) {
+	arbitersMu.Lock()
+	defer arbitersMu.Unlock()
+	arbiters[policy] = factory
+}
+
+// NewArbiter crée un arbitre pour la politique donnée.
+func NewArbiter(policy ArbitrationPolicy) (Arbiter, error) {
+	arbitersMu.RLock()
+	factory, exists := arbiters[policy]
+	arbitersMu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("politique d'arbitrage inconnue: %s", policy)
+	}
+	return factory(), nil
+}
+
+// Judge vérifie la réponse d'une tentative contre le défi anagramme du mot.
+func Judge(word Word, attempt Attempt) Judgement {
+	return JudgeChallenge(NewAnagramChallenge(word), attempt)
+}
+
+// JudgeChallenge vérifie la réponse d'une tentative contre un défi déjà construit.
+func JudgeChallenge(challenge Challenge, attempt Attempt) Judgement {
+	at := attempt.SubmittedAt
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	correct, err := challenge.Check(attempt.Answer)
+	return Judgement{
+		Attempt: attempt,
+		Correct: correct,
+		Err:     err,
+		At:      at,
+	}
+}
+
+// firstAttemptArbiter : la première tentative décide (comportement historique)
+type firstAttemptArbiter struct {
+	first *Judgement
+}
+
+func (a *firstAttemptArbiter) Offer(j Judgement) ArbiterDecision {
+	a.first = &j
+	return ArbiterDecided
+}
+
+func (a *firstAttemptArbiter) Verdict() Verdict {
+	switch {
+	case a.first == nil:
+		return Verdict{Reason: VerdictTimeout}
+	case a.first.Correct:
+		return Verdict{Winners: []Judgement{*a.first}, Reason: VerdictCaptured}
+	default:
+		return Verdict{Reason: VerdictWrongAttempt}
+	}
+}
+
+// firstCorrectArbiter : la première bonne réponse avant le timeout l'emporte
+type firstCorrectArbiter struct {
+	winner *Judgement
+}
+
+func (a *firstCorrectArbiter) Offer(j Judgement) ArbiterDecision {
+	if !j.Correct {
+		return ArbiterPending
+	}
+	a.winner = &j
+	return ArbiterDecided
+}
+
+func (a *firstCorrectArbiter) Verdict() Verdict {
+	if a.winner == nil {
+		return Verdict{Reason: VerdictTimeout}
+	}
+	return Verdict{Winners: []Judgement{*a.winner}, Reason: VerdictCaptured}
+}
+
+// fastestCorrectArbiter : collecte pendant la fenêtre puis la réponse correcte la plus rapide l'emporte
+type fastestCorrectArbiter struct {
+	fastest *Judgement
+}
+
+func (a *fastestCorrectArbiter) Offer(j Judgement) ArbiterDecision {
+	if !j.Correct {
+		return ArbiterPending
+	}
+	if a.fastest == nil || j.At.Before(a.fastest.At) {
+		a.fastest = &j
+	}
+	return ArbiterOpenWindow
+}
+
+func (a *fastestCorrectArbiter) Verdict() Verdict {
+	if a.fastest == nil {
+		return Verdict{Reason: VerdictTimeout}
+	}
+	return Verdict{Winners: []Judgement{*a.fastest}, Reason: VerdictCaptured}
+}
+
+// sharedCaptureArbiter : chaque joueur correct pendant la fenêtre capture le WordMon
+type sharedCaptureArbiter struct {
+	winners []Judgement
+	seen    map[string]bool
+}
+
+func (a *sharedCaptureArbiter) Offer(j Judgement) ArbiterDecision {
+	if !j.Correct {
+		return ArbiterPending
+	}
+	if !a.seen[j.Attempt.PlayerID] {
+		a.seen[j.Attempt.PlayerID] = true
+		a.winners = append(a.winners, j)
+	}
+	return ArbiterOpenWindow
+}
+
+func (a *sharedCaptureArbiter) Verdict() Verdict {
+	if len(a.winners) == 0 {
+		return Verdict{Reason: VerdictTimeout}
+	}
+	return Verdict{Winners: a.winners, Reason: VerdictCaptured}
+}
+
+// Referee pilote l'arbitrage d'un combat : fenêtre de collecte, timeout et verdict.
+type Referee struct {
+	mu          sync.Mutex
+	arbiter     Arbiter
+	window      time.Duration
+	windowTimer *time.Timer
+	timeout     *time.Timer
+	decided     bool
+	verdict     Verdict
+	onDecided   func(Verdict)
+	done        chan struct{}
+}
+
+// NewReferee crée l'arbitre d'un combat. onDecided (optionnel) est appelé une
+// seule fois avec le verdict, avant que Done ne soit fermé.
+func NewReferee(cfg ArbitrationConfig, timeout time.Duration, onDecided func(Verdict)) (*Referee, error) {
+	arbiter, err := NewArbiter(cfg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Referee{
+		arbiter:   arbiter,
+		window:    cfg.Window,
+		onDecided: onDecided,
+		done:      make(chan struct{}),
+	}
+	if timeout > 0 {
+		r.timeout = time.AfterFunc(timeout, r.Close)
+	}
+
+	return r, nil
+}
+
+// Submit soumet une tentative jugée. Retourne false si le combat est déjà décidé.
+func (r *Referee) Submit(j Judgement) bool {
+	r.mu.Lock()
+	if r.decided {
+		r.mu.Unlock()
+		return false
+	}
+
+	switch r.arbiter.Offer(j) {
+	case ArbiterDecided:
+		r.mu.Unlock()
+		r.Close()
+		return true
+	case ArbiterOpenWindow:
+		if r.windowTimer == nil {
+			r.windowTimer = time.AfterFunc(r.window, r.Close)
+		}
+	}
+
+	r.mu.Unlock()
+	return true
+}
+
+// Close clôt le combat et rend le verdict (timeout, fin de fenêtre ou fuite).
+func (r *Referee) Close() {
+	r.mu.Lock()
+	if r.decided {
+		r.mu.Unlock()
+		return
+	}
+	r.decided = true
+	r.verdict = r.arbiter.Verdict()
+	if r.windowTimer != nil {
+		r.windowTimer.Stop()
+	}
+	if r.timeout != nil {
+		r.timeout.Stop()
+	}
+	verdict := r.verdict
+	r.mu.Unlock()
+
+	if r.onDecided != nil {
+		r.onDecided(verdict)
+	}
+	close(r.done)
+}
+
+// Done retourne un canal fermé lorsque le verdict est rendu.
+func (r *Referee) Done() <-chan struct{} {
+	return r.done
+}
+
+// Decided indique si le combat est déjà décidé.
+func (r *Referee) Decided() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.decided
+}
+
+// Verdict retourne le verdict du combat (valide une fois Done fermé).
+func (r *Referee) Verdict() Verdict {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.verdict
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// judged construit une tentative jugée pour les tests d'arbitrage
+func judged(playerID string, correct bool, at time.Time) Judgement {
+	return Judgement{
+		Attempt: Attempt{PlayerID: playerID, Answer: "x"},
+		Correct: correct,
+		At:      at,
+	}
+}
+
+// waitVerdict attend le verdict de l'arbitre
+func waitVerdict(t *testing.T, r *Referee) Verdict {
+	t.Helper()
+	select {
+	case <-r.Done():
+		return r.Verdict()
+	case <-time.After(2 * time.Second):
+		t.Fatal("verdict non rendu")
+		return Verdict{}
+	}
+}
+
+func TestFirstAttemptWinsWrongAnswerFlees(t *testing.T) {
+	r, err := NewReferee(ArbitrationConfig{Policy: FirstAttemptWins}, time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	r.Submit(judged("charlie", false, now))
+	if r.Submit(judged("alice", true, now.Add(time.Millisecond))) {
+		t.Error("une tentative après la décision doit être refusée")
+	}
+
+	v := waitVerdict(t, r)
+	if v.Reason != VerdictWrongAttempt || len(v.Winners) != 0 {
+		t.Errorf("verdict inattendu: %+v", v)
+	}
+}
+
+func TestFirstCorrectWinsIgnoresWrongAnswers(t *testing.T) {
+	r, _ := NewReferee(ArbitrationConfig{Policy: FirstCorrectWins}, time.Second, nil)
+
+	now := time.Now()
+	r.Submit(judged("charlie", false, now))
+	r.Submit(judged("alice", true, now.Add(time.Millisecond)))
+
+	v := waitVerdict(t, r)
+	if !v.HasWinner("alice") || len(v.Winners) != 1 {
+		t.Errorf("alice devrait gagner seule: %+v", v)
+	}
+}
+
+func TestFastestCorrectWinsAfterWindow(t *testing.T) {
+	r, _ := NewReferee(ArbitrationConfig{Policy: FastestCorrectWins, Window: 50 * time.Millisecond}, time.Second, nil)
+
+	now := time.Now()
+	r.Submit(judged("bob", true, now.Add(20*time.Millisecond)))
+	r.Submit(judged("diana", true, now))
+	if r.Decided() {
+		t.Fatal("le combat ne doit pas être décidé avant la fin de la fenêtre")
+	}
+
+	v := waitVerdict(t, r)
+	if !v.HasWinner("diana") || len(v.Winners) != 1 {
+		t.Errorf("diana (la plus rapide) devrait gagner: %+v", v)
+	}
+}
+
+func TestSharedCaptureRewardsEveryCorrectPlayer(t *testing.T) {
+	var decided Verdict
+	r, _ := NewReferee(ArbitrationConfig{Policy: SharedCapture, Window: 50 * time.Millisecond}, time.Second,
+		func(v Verdict) { decided = v })
+
+	now := time.Now()
+	r.Submit(judged("alice", true, now))
+	r.Submit(judged("charlie", false, now))
+	r.Submit(judged("bob", true, now))
+	r.Submit(judged("alice", true, now))
+
+	waitVerdict(t, r)
+	if len(decided.Winners) != 2 || !decided.HasWinner("alice") || !decided.HasWinner("bob") {
+		t.Errorf("alice et bob devraient partager la capture: %+v", decided)
+	}
+}
+
+func TestRefereeTimeout(t *testing.T) {
+	r, _ := NewReferee(ArbitrationConfig{Policy: FirstCorrectWins}, 20*time.Millisecond, nil)
+	r.Submit(judged("charlie", false, time.Now()))
+
+	v := waitVerdict(t, r)
+	if v.Reason != VerdictTimeout {
+		t.Errorf("timeout attendu, reçu: %s", v.Reason)
+	}
+}
+
+func TestUnknownArbitrationPolicy(t *testing.T) {
+	if _, err := NewReferee(ArbitrationConfig{Policy: "random"}, time.Second, nil); err == nil {
+		t.Error("une politique inconnue doit être refusée")
+	}
+}
@@ -20,8 +20,17 @@ type AnagramChallenge struct {
 	Rarity       Rarity
 	MaxAttempts  int
 	CurrentTries int
+	AllowSource  bool // le mot d'origine compte comme anagramme
 }
 
+// AnagramRules règles configurables du défi anagramme (configs/challenges.yaml).
+type AnagramRules struct {
+	MustDifferFromSource bool
+}
+
+// DefaultAnagramRules règles historiques : le mot d'origine est refusé.
+var DefaultAnagramRules = AnagramRules{MustDifferFromSource: true}
+
 // NewAnagramChallenge crée un nouveau défi anagramme pour un mot donné.
 func NewAnagramChallenge(word Word) *AnagramChallenge {
 	challenge := &AnagramChallenge{
@@ -32,6 +41,13 @@ func NewAnagramChallenge(word Word) *AnagramChallenge {
 	return challenge
 }
 
+// NewAnagramChallengeWithRules crée un défi anagramme selon les règles configurées.
+func NewAnagramChallengeWithRules(word Word, rules AnagramRules) *AnagramChallenge {
+	challenge := NewAnagramChallenge(word)
+	challenge.AllowSource = !rules.MustDifferFromSource
+	return challenge
+}
+
 // Instructions retourne la consigne du défi anagramme.
 func (ac *AnagramChallenge) Instructions() string {
 	return "Défi : Donne un anagramme correct du mot '" + ac.TargetWord + "'"
@@ -64,7 +80,7 @@ func (ac *AnagramChallenge) Check(attempt string) (bool, error) {
 	}
 
 	// Vérifier que ce n'est pas le mot original
-	if attempt == target {
+	if attempt == target && !ac.AllowSource {
 		return false, InvalidAttemptError{
 			Input:  attempt,
 			Reason: "doit être différent du mot original",
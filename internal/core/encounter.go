@@ -1,7 +1,10 @@
 // Package core contient les types et fonctions principaux du jeu WordMon.
 package core
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // EncounterState représente l'état d'une rencontre WordMon.
 type EncounterState string
@@ -22,6 +25,7 @@ type Encounter struct {
 	CurrentMon *WordMon
 	Player     *Player
 	BattleLog  []string
+	bus        *EventBus
 }
 
 // NewEncounter crée une nouvelle instance de rencontre WordMon.
@@ -32,6 +36,11 @@ func NewEncounter() *Encounter {
 	}
 }
 
+// SetEventBus définit le bus sur lequel la rencontre publie ses événements.
+func (e *Encounter) SetEventBus(bus *EventBus) {
+	e.bus = bus
+}
+
 // Start démarre une nouvelle rencontre (IDLE → ENCOUNTERED).
 func (e *Encounter) Start(player *Player) error {
 	if e.State != IDLE {
@@ -52,6 +61,7 @@ func (e *Encounter) Start(player *Player) error {
 	e.BattleLog = make([]string, 0)
 
 	e.addLog(e.CurrentMon.Presentation())
+	e.bus.Publish(SpawnCreated{Word: word, At: time.Now().UTC()})
 	return nil
 }
 
@@ -92,6 +102,13 @@ func (e *Encounter) SubmitAttempt(input string) error {
 	challenge := e.CurrentMon.GetChallenge()
 
 	success, err := challenge.Check(input)
+	e.bus.Publish(AttemptMade{
+		PlayerID: e.Player.ID,
+		Word:     e.CurrentMon.Word,
+		Answer:   input,
+		Correct:  success,
+		At:       time.Now().UTC(),
+	})
 	if err != nil {
 		e.addLog(fmt.Sprintf("Tentative '%s' → ERREUR: %s", input, err.Error()))
 		return fmt.Errorf("erreur de tentative: %w", err)
@@ -136,6 +153,8 @@ func (e *Encounter) Resolve() error {
 
 // resolveVictory gère la victoire (WON → CAPTURED → IDLE)
 func (e *Encounter) resolveVictory() error {
+	previousLevel := e.Player.Level
+
 	// Capturer le WordMon
 	if err := e.Player.Capture(e.CurrentMon.Word); err != nil {
 		return fmt.Errorf("erreur lors de la capture: %w", err)
@@ -150,6 +169,7 @@ func (e *Encounter) resolveVictory() error {
 		e.CurrentMon.Word.Text, e.CurrentMon.Word.Points, e.Player.Level))
 
 	e.State = CAPTURED
+	e.bus.Publish(CaptureEvents(*e.Player, e.CurrentMon.Word, previousLevel)...)
 
 	// Retour à IDLE
 	e.reset()
@@ -160,6 +180,7 @@ func (e *Encounter) resolveVictory() error {
 func (e *Encounter) resolveDefeat() error {
 	e.addLog("Le WordMon s'échappe dans un nuage de lettres...")
 	e.State = FLED
+	e.bus.Publish(SpawnFled{Word: e.CurrentMon.Word, Reason: VerdictWrongAttempt, At: time.Now().UTC()})
 
 	// Retour à IDLE
 	e.reset()
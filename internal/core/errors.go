@@ -58,3 +58,12 @@ type ChallengeError struct {
 func (e ChallengeError) Error() string {
 	return fmt.Sprintf("erreur de défi: '%s' (%s)", e.Input, e.Reason)
 }
+
+// BattleClosedError représente une tentative adressée à un combat fermé ou inconnu
+type BattleClosedError struct {
+	BattleID string
+}
+
+func (e BattleClosedError) Error() string {
+	return fmt.Sprintf("combat fermé ou inconnu: %s", e.BattleID)
+}
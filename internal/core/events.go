@@ -0,0 +1,258 @@
+// Package core contient les types et fonctions principaux du jeu WordMon.
+package core
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Noms des événements du domaine.
+const (
+	EventSpawnCreated  = "SpawnCreated"
+	EventSpawnFled     = "SpawnFled"
+	EventAttemptMade   = "AttemptMade"
+	EventWordCaptured  = "WordCaptured"
+	EventLevelUp       = "LevelUp"
+	EventPlayerCreated = "PlayerCreated"
+)
+
+// DomainEvent est implémenté par tous les événements publiés sur le bus.
+type DomainEvent interface {
+	EventName() string
+}
+
+// SpawnCreated : un WordMon apparaît.
+type SpawnCreated struct {
+	BattleID string
+	Word     Word
+	At       time.Time
+}
+
+// SpawnFled : un WordMon s'enfuit sans être capturé.
+type SpawnFled struct {
+	BattleID string
+	Word     Word
+	Reason   string
+	At       time.Time
+}
+
+// AttemptMade : un joueur a tenté une capture.
+type AttemptMade struct {
+	BattleID string
+	PlayerID string
+	Word     Word
+	Answer   string
+	Correct  bool
+	At       time.Time
+}
+
+// WordCaptured : un joueur a capturé un WordMon et gagné de l'XP.
+type WordCaptured struct {
+	PlayerID   string
+	PlayerName string
+	Word       Word
+	XPGained   int
+	XP         int
+	Level      int
+	At         time.Time
+}
+
+// LevelUp : un joueur passe au niveau supérieur.
+type LevelUp struct {
+	PlayerID      string
+	PlayerName    string
+	PreviousLevel int
+	Level         int
+	At            time.Time
+}
+
+// PlayerCreated : un nouveau joueur est inscrit.
+type PlayerCreated struct {
+	PlayerID string
+	Name     string
+	At       time.Time
+}
+
+// EventName implémente DomainEvent.
+func (SpawnCreated) EventName() string { return EventSpawnCreated }
+
+// EventName implémente DomainEvent.
+func (SpawnFled) EventName() string { return EventSpawnFled }
+
+// EventName implémente DomainEvent.
+func (AttemptMade) EventName() string { return EventAttemptMade }
+
+// EventName implémente DomainEvent.
+func (WordCaptured) EventName() string { return EventWordCaptured }
+
+// EventName implémente DomainEvent.
+func (LevelUp) EventName() string { return EventLevelUp }
+
+// EventName implémente DomainEvent.
+func (PlayerCreated) EventName() string { return EventPlayerCreated }
+
+// CaptureEvents construit les événements d'une capture : WordCaptured et,
+// si le niveau change, LevelUp.
+func CaptureEvents(p Player, w Word, previousLevel int) []DomainEvent {
+	now := time.Now().UTC()
+	events := []DomainEvent{WordCaptured{
+		PlayerID:   p.ID,
+		PlayerName: p.Name,
+		Word:       w,
+		XPGained:   w.Points,
+		XP:         p.XP,
+		Level:      p.Level,
+		At:         now,
+	}}
+
+	if p.Level > previousLevel {
+		events = append(events, LevelUp{
+			PlayerID:      p.ID,
+			PlayerName:    p.Name,
+			PreviousLevel: previousLevel,
+			Level:         p.Level,
+			At:            now,
+		})
+	}
+
+	return events
+}
+
+// EventHandler traite un événement du domaine.
+type EventHandler func(DomainEvent)
+
+// subscription représente un abonné du bus, synchrone ou asynchrone.
+type subscription struct {
+	handler EventHandler
+	names   map[string]bool
+	queue   chan DomainEvent // nil pour un abonné synchrone
+}
+
+// EventBus diffuse les événements du domaine aux abonnés enregistrés.
+// Un bus nil est valide : la publication est alors sans effet.
+type EventBus struct {
+	mu      sync.RWMutex
+	subs    map[*subscription]struct{}
+	dropped atomic.Int64
+}
+
+// NewEventBus crée un bus d'événements vide.
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subs: make(map[*subscription]struct{}),
+	}
+}
+
+// Subscribe enregistre un abonné synchrone, appelé dans la goroutine de publication.
+// names restreint les événements reçus (tous si vide).
+func (b *EventBus) Subscribe(handler EventHandler, names ...string) (unsubscribe func()) {
+	return b.add(&subscription{handler: handler, names: nameSet(names)})
+}
+
+// SubscribeAsync enregistre un abonné asynchrone avec une file bornée à buffer
+// événements. Quand la file est pleine, l'événement est abandonné pour cet abonné.
+func (b *EventBus) SubscribeAsync(buffer int, handler EventHandler, names ...string) (unsubscribe func()) {
+	sub := &subscription{
+		handler: handler,
+		names:   nameSet(names),
+		queue:   make(chan DomainEvent, buffer),
+	}
+
+	go func() {
+		for event := range sub.queue {
+			dispatch(sub.handler, event)
+		}
+	}()
+
+	return b.add(sub)
+}
+
+// Publish diffuse un événement à tous les abonnés intéressés. Les abonnés
+// synchrones sont appelés après libération du verrou : ils peuvent s'abonner,
+// se désabonner ou publier à leur tour.
+func (b *EventBus) Publish(events ...DomainEvent) {
+	if b == nil {
+		return
+	}
+
+	type call struct {
+		handler EventHandler
+		event   DomainEvent
+	}
+	var calls []call
+
+	// Les files asynchrones sont alimentées sous le verrou : un désabonnement
+	// concurrent ne peut pas les fermer pendant l'envoi
+	b.mu.RLock()
+	for _, event := range events {
+		for sub := range b.subs {
+			if len(sub.names) > 0 && !sub.names[event.EventName()] {
+				continue
+			}
+
+			if sub.queue == nil {
+				calls = append(calls, call{sub.handler, event})
+				continue
+			}
+
+			select {
+			case sub.queue <- event:
+			default:
+				b.dropped.Add(1)
+			}
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, c := range calls {
+		dispatch(c.handler, c.event)
+	}
+}
+
+// Dropped retourne le nombre d'événements abandonnés par des abonnés asynchrones saturés.
+func (b *EventBus) Dropped() int64 {
+	return b.dropped.Load()
+}
+
+// add enregistre un abonné et retourne sa fonction de désabonnement
+func (b *EventBus) add(sub *subscription) func() {
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, sub)
+			b.mu.Unlock()
+			if sub.queue != nil {
+				close(sub.queue)
+			}
+		})
+	}
+}
+
+// dispatch appelle un abonné en isolant ses panics du publieur
+func dispatch(handler EventHandler, event DomainEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("[events] abonné en erreur sur %s: %v\n", event.EventName(), r)
+		}
+	}()
+	handler(event)
+}
+
+// nameSet convertit une liste de noms d'événements en ensemble
+func nameSet(names []string) map[string]bool {
+	if len(names) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventBusFiltersByName(t *testing.T) {
+	bus := NewEventBus()
+
+	var names []string
+	bus.Subscribe(func(e DomainEvent) { names = append(names, e.EventName()) }, EventWordCaptured)
+
+	word := Word{ID: "c_1", Text: "chat", Rarity: Common, Points: 120}
+	bus.Publish(SpawnCreated{Word: word})
+	bus.Publish(CaptureEvents(Player{ID: "p1", Name: "Alice", XP: 120, Level: 2}, word, 1)...)
+
+	if got := strings.Join(names, ","); got != EventWordCaptured {
+		t.Errorf("événements reçus inattendus: %s", got)
+	}
+}
+
+func TestEventBusIsolatesPanickingSubscriber(t *testing.T) {
+	bus := NewEventBus()
+	bus.Subscribe(func(DomainEvent) { panic("boom") })
+
+	received := 0
+	bus.Subscribe(func(DomainEvent) { received++ })
+
+	bus.Publish(PlayerCreated{PlayerID: "p1", Name: "Alice"})
+	if received != 1 {
+		t.Errorf("l'abonné sain devrait recevoir l'événement, reçu %d", received)
+	}
+}
+
+func TestEventBusSyncSubscriberCanUnsubscribe(t *testing.T) {
+	bus := NewEventBus()
+
+	received := 0
+	var unsubscribe func()
+	unsubscribe = bus.Subscribe(func(DomainEvent) {
+		received++
+		unsubscribe()
+		bus.Subscribe(func(DomainEvent) {})
+	})
+
+	done := make(chan struct{})
+	go func() {
+		bus.Publish(PlayerCreated{PlayerID: "p1", Name: "Alice"})
+		bus.Publish(PlayerCreated{PlayerID: "p2", Name: "Bob"})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publication bloquée par un abonné qui modifie le bus")
+	}
+	if received != 1 {
+		t.Errorf("1 événement attendu avant désabonnement, reçu %d", received)
+	}
+}
+
+func TestEventBusAsyncDropsWhenFull(t *testing.T) {
+	bus := NewEventBus()
+	release := make(chan struct{})
+	done := make(chan struct{}, 4)
+	unsubscribe := bus.SubscribeAsync(1, func(DomainEvent) {
+		<-release
+		done <- struct{}{}
+	})
+	defer unsubscribe()
+
+	// Le premier événement bloque l'abonné, le second remplit la file, les suivants sont perdus
+	for i := 0; i < 4; i++ {
+		bus.Publish(PlayerCreated{PlayerID: "p1"})
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(release)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("événement asynchrone non traité")
+		}
+	}
+	if bus.Dropped() != 2 {
+		t.Errorf("attendu 2 événements perdus, obtenu %d", bus.Dropped())
+	}
+}
+
+func TestAchievementsUnlockOnce(t *testing.T) {
+	var out bytes.Buffer
+	achievements := NewAchievements(&out)
+	bus := NewEventBus()
+	bus.Subscribe(achievements.Handle)
+
+	legendary := Word{ID: "l_1", Text: "dragon", Rarity: Legendary, Points: 500}
+	bus.Publish(CaptureEvents(Player{ID: "p1", Name: "Alice", XP: 500, Level: 6}, legendary, 1)...)
+	bus.Publish(CaptureEvents(Player{ID: "p1", Name: "Alice", XP: 1000, Level: 11}, legendary, 6)...)
+
+	got := strings.Join(achievements.Unlocked("p1"), ",")
+	if got != "first-capture,legendary-hunter,veteran" {
+		t.Errorf("succès inattendus: %s", got)
+	}
+	if n := strings.Count(out.String(), "[achievement]"); n != 3 {
+		t.Errorf("attendu 3 annonces, obtenu %d", n)
+	}
+}
@@ -0,0 +1,146 @@
+// Package core contient les types et fonctions principaux du jeu WordMon.
+package core
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// NewEventLogger retourne un abonné qui journalise les événements des joueurs.
+func NewEventLogger(w io.Writer) EventHandler {
+	return func(e DomainEvent) {
+		switch ev := e.(type) {
+		case PlayerCreated:
+			fmt.Fprintf(w, "[player] Joueur créé: %s (id=%s)\n", ev.Name, ev.PlayerID)
+		case WordCaptured:
+			fmt.Fprintf(w, "[player] %s a capturé \"%s\" (XP+%d)\n", ev.PlayerName, ev.Word.Text, ev.XPGained)
+		case LevelUp:
+			fmt.Fprintf(w, "[player] %s passe au niveau %d\n", ev.PlayerName, ev.Level)
+		case SpawnFled:
+			fmt.Fprintf(w, "[spawn] \"%s\" s'est enfui (%s)\n", ev.Word.Text, ev.Reason)
+		}
+	}
+}
+
+// EventMetrics compte les événements du domaine par nom.
+type EventMetrics struct {
+	mu     sync.Mutex
+	counts map[string]int64
+	xp     int64
+}
+
+// NewEventMetrics crée un compteur d'événements vide.
+func NewEventMetrics() *EventMetrics {
+	return &EventMetrics{counts: make(map[string]int64)}
+}
+
+// Handle implémente EventHandler.
+func (m *EventMetrics) Handle(e DomainEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.counts[e.EventName()]++
+	if ev, ok := e.(WordCaptured); ok {
+		m.xp += int64(ev.XPGained)
+	}
+}
+
+// Snapshot retourne une copie des compteurs (plus l'XP totale distribuée).
+func (m *EventMetrics) Snapshot() map[string]int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]int64, len(m.counts)+1)
+	for name, count := range m.counts {
+		snapshot[name] = count
+	}
+	snapshot["XPAwarded"] = m.xp
+	return snapshot
+}
+
+// Identifiants des succès débloqués par les joueurs.
+const (
+	AchievementFirstCapture = "first-capture"
+	AchievementLegendary    = "legendary-hunter"
+	AchievementCollector    = "collector"
+	AchievementVeteran      = "veteran"
+)
+
+// Seuils de déblocage des succès
+const (
+	collectorDistinctWords = 10
+	veteranLevel           = 5
+)
+
+// Achievements débloque des succès à partir des événements de capture.
+type Achievements struct {
+	mu       sync.Mutex
+	unlocked map[string]map[string]bool
+	words    map[string]map[string]bool
+	out      io.Writer
+}
+
+// NewAchievements crée un suivi des succès ; out (optionnel) reçoit les annonces.
+func NewAchievements(out io.Writer) *Achievements {
+	return &Achievements{
+		unlocked: make(map[string]map[string]bool),
+		words:    make(map[string]map[string]bool),
+		out:      out,
+	}
+}
+
+// Handle implémente EventHandler.
+func (a *Achievements) Handle(e DomainEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch ev := e.(type) {
+	case WordCaptured:
+		a.unlock(ev.PlayerID, ev.PlayerName, AchievementFirstCapture)
+		if ev.Word.Rarity == Legendary {
+			a.unlock(ev.PlayerID, ev.PlayerName, AchievementLegendary)
+		}
+
+		if a.words[ev.PlayerID] == nil {
+			a.words[ev.PlayerID] = make(map[string]bool)
+		}
+		a.words[ev.PlayerID][ev.Word.ID] = true
+		if len(a.words[ev.PlayerID]) >= collectorDistinctWords {
+			a.unlock(ev.PlayerID, ev.PlayerName, AchievementCollector)
+		}
+	case LevelUp:
+		if ev.Level >= veteranLevel {
+			a.unlock(ev.PlayerID, ev.PlayerName, AchievementVeteran)
+		}
+	}
+}
+
+// Unlocked retourne les succès débloqués par un joueur, triés.
+func (a *Achievements) Unlocked(playerID string) []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	result := make([]string, 0, len(a.unlocked[playerID]))
+	for id := range a.unlocked[playerID] {
+		result = append(result, id)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// unlock débloque un succès (appelé sous verrou)
+func (a *Achievements) unlock(playerID, playerName, achievement string) {
+	if a.unlocked[playerID] == nil {
+		a.unlocked[playerID] = make(map[string]bool)
+	}
+	if a.unlocked[playerID][achievement] {
+		return
+	}
+	a.unlocked[playerID][achievement] = true
+
+	if a.out != nil {
+		fmt.Fprintf(a.out, "[achievement] %s débloque \"%s\"\n", playerName, achievement)
+	}
+}
@@ -1,6 +1,8 @@
 // Package core contient les types et fonctions principaux du jeu WordMon.
 package core
 
+import "time"
+
 // Rarity représente la rareté d'un WordMon (common, rare, legendary).
 type Rarity string
 
@@ -17,6 +19,7 @@ type Player struct {
 	Name      string
 	XP        int
 	Level     int
+	Team      string         // équipe du joueur ("" : aucune)
 	Inventory map[string]int // clé = mot, valeur = nb capturés
 }
 
@@ -28,6 +31,21 @@ type Word struct {
 	Points int
 }
 
+// Spawn représente l'apparition d'un WordMon et le combat qui lui est associé.
+type Spawn struct {
+	BattleID string
+	Word     Word
+}
+
+// Attempt représente une tentative de capture d'un joueur.
+type Attempt struct {
+	BattleID    string
+	PlayerID    string
+	Answer      string
+	Word        Word
+	SubmittedAt time.Time
+}
+
 // NewPlayer crée un nouveau joueur avec l'inventaire initialisé.
 func NewPlayer(id, name string) Player {
 	return Player{
@@ -3,6 +3,7 @@ package core
 
 import (
 	"math/rand"
+	"sync/atomic"
 )
 
 // WordEntry représente une entrée de mot pour la configuration initiale.
@@ -26,71 +27,57 @@ type XPRewards struct {
 	Legendary int
 }
 
-// Variables globales pour les pools configurables
-var (
-	poolCommon    []Word
-	poolRare      []Word
-	poolLegendary []Word
-	rarityWeights struct {
-		Common    int
-		Rare      int
-		Legendary int
-	}
-	xpRewards struct {
-		Common    int
-		Rare      int
-		Legendary int
-	}
-)
+// wordPools pools de mots et paramètres configurés, remplacés d'un bloc par
+// ConfigureWords (rechargement de la config pendant une partie)
+type wordPools struct {
+	common    []Word
+	rare      []Word
+	legendary []Word
+	weights   RarityWeights
+	rewards   XPRewards
+}
+
+// pools configuration courante (nil : pools par défaut)
+var pools atomic.Pointer[wordPools]
 
 // ConfigureWords configure les pools de mots et les paramètres depuis la config.
+// Les tirages en cours gardent l'ancienne configuration.
 func ConfigureWords(words []WordEntry, weights RarityWeights, rewards XPRewards) {
-	// Réinitialiser les pools
-	poolCommon = make([]Word, 0)
-	poolRare = make([]Word, 0)
-	poolLegendary = make([]Word, 0)
+	configured := &wordPools{weights: weights, rewards: rewards}
 
 	// Organiser les mots par rareté
 	for _, entry := range words {
 		word := Word{
 			ID:     entry.ID,
 			Text:   entry.Text,
-			Rarity: parseRarity(entry.Rarity),
+			Rarity: ParseRarity(entry.Rarity),
 		}
 
 		// Assigner les points selon la rareté et la config
 		switch word.Rarity {
 		case Common:
 			word.Points = rewards.Common
-			poolCommon = append(poolCommon, word)
+			configured.common = append(configured.common, word)
 		case Rare:
 			word.Points = rewards.Rare
-			poolRare = append(poolRare, word)
+			configured.rare = append(configured.rare, word)
 		case Legendary:
 			word.Points = rewards.Legendary
-			poolLegendary = append(poolLegendary, word)
+			configured.legendary = append(configured.legendary, word)
 		}
 	}
 
-	// Configurer les poids
-	rarityWeights.Common = weights.Common
-	rarityWeights.Rare = weights.Rare
-	rarityWeights.Legendary = weights.Legendary
-
-	// Configurer les récompenses
-	xpRewards.Common = rewards.Common
-	xpRewards.Rare = rewards.Rare
-	xpRewards.Legendary = rewards.Legendary
+	pools.Store(configured)
 }
 
-// parseRarity convertit une string en enum Rarity.
-func parseRarity(rarity string) Rarity {
+// ParseRarity convertit une rareté de la config ("Common", "Rare", "Legendary") en enum Rarity.
+func ParseRarity(rarity string) Rarity {
 	switch rarity {
-	case "Common":
+	case "Common", "common":
 		return Common
-	case "Rare":
+	case "Rare", "rare":
 		return Rare
-	case "Legendary":
+	case "Legendary", "legendary":
 		return Legendary
 	default:
 		return Common // Valeur par défaut
@@ -100,67 +87,64 @@ func parseRarity(rarity string) Rarity {
 // SpawnWord retourne un mot aléatoire en respectant les pondérations configurées.
 func SpawnWord() Word {
 	// Utiliser les pools par défaut si pas configuré
-	if len(poolCommon) == 0 {
-		initDefaultPools()
+	p := pools.Load()
+	if p == nil || len(p.common) == 0 {
+		p = defaultPools
 	}
 
 	// Générer un nombre aléatoire entre 0 et 99
 	roll := rand.Intn(100)
 
 	// Utiliser les poids configurés
-	commonThreshold := rarityWeights.Common
-	rareThreshold := commonThreshold + rarityWeights.Rare
+	commonThreshold := p.weights.Common
+	rareThreshold := commonThreshold + p.weights.Rare
 
 	switch {
-	case roll < commonThreshold && len(poolCommon) > 0:
-		return poolCommon[rand.Intn(len(poolCommon))]
-	case roll < rareThreshold && len(poolRare) > 0:
-		return poolRare[rand.Intn(len(poolRare))]
-	case len(poolLegendary) > 0:
-		return poolLegendary[rand.Intn(len(poolLegendary))]
+	case roll < commonThreshold && len(p.common) > 0:
+		return p.common[rand.Intn(len(p.common))]
+	case roll < rareThreshold && len(p.rare) > 0:
+		return p.rare[rand.Intn(len(p.rare))]
+	case len(p.legendary) > 0:
+		return p.legendary[rand.Intn(len(p.legendary))]
 	default:
 		// Fallback si pas de mots dans la catégorie
-		if len(poolCommon) > 0 {
-			return poolCommon[rand.Intn(len(poolCommon))]
+		if len(p.common) > 0 {
+			return p.common[rand.Intn(len(p.common))]
 		}
 		// Fallback ultime
 		return Word{ID: "fallback", Text: "mot", Rarity: Common, Points: 1}
 	}
 }
 
-// initDefaultPools initialise les pools par défaut (rétrocompatibilité).
-func initDefaultPools() {
-	poolCommon = []Word{
+// defaultPools pools par défaut (rétrocompatibilité).
+var defaultPools = &wordPools{
+	common: []Word{
 		{ID: "c001", Text: "chat", Rarity: Common, Points: 5},
 		{ID: "c002", Text: "chien", Rarity: Common, Points: 5},
 		{ID: "c003", Text: "maison", Rarity: Common, Points: 5},
 		{ID: "c004", Text: "soleil", Rarity: Common, Points: 5},
 		{ID: "c005", Text: "eau", Rarity: Common, Points: 5},
-	}
+	},
 
-	poolRare = []Word{
+	rare: []Word{
 		{ID: "r001", Text: "licorne", Rarity: Rare, Points: 20},
 		{ID: "r002", Text: "phoenix", Rarity: Rare, Points: 20},
 		{ID: "r003", Text: "cristal", Rarity: Rare, Points: 20},
 		{ID: "r004", Text: "tempête", Rarity: Rare, Points: 20},
 		{ID: "r005", Text: "étoile", Rarity: Rare, Points: 20},
-	}
+	},
 
-	poolLegendary = []Word{
+	legendary: []Word{
 		{ID: "l001", Text: "dragon", Rarity: Legendary, Points: 100},
 		{ID: "l002", Text: "excalibur", Rarity: Legendary, Points: 100},
 		{ID: "l003", Text: "atlantide", Rarity: Legendary, Points: 100},
 		{ID: "l004", Text: "immortel", Rarity: Legendary, Points: 100},
 		{ID: "l005", Text: "cosmos", Rarity: Legendary, Points: 100},
-	}
+	},
 
 	// Poids par défaut
-	rarityWeights.Common = 80
-	rarityWeights.Rare = 18
-	rarityWeights.Legendary = 2
+	weights: RarityWeights{Common: 80, Rare: 18, Legendary: 2},
 
 	// Récompenses par défaut
-	xpRewards.Common = 5
-	xpRewards.Rare = 20
-	xpRewards.Legendary = 100
+	rewards: XPRewards{Common: 5, Rare: 20, Legendary: 100},
 }
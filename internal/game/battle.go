@@ -0,0 +1,155 @@
+package game
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// ArbitrationFromConfig convertit la section battle de la config vers core
+func ArbitrationFromConfig(gameConfig *config.GameConfig) core.ArbitrationConfig {
+	return core.ArbitrationConfig{
+		Policy: core.ArbitrationPolicy(gameConfig.Battle.Policy),
+		Window: time.Duration(gameConfig.Battle.WindowMs) * time.Millisecond,
+	}
+}
+
+// battleBoard associe au spawn courant l'arbitre de son combat.
+// Le spawn est identifié par son BattleID.
+type battleBoard struct {
+	current func() *core.Spawn // spawn en jeu (celui du spawner)
+
+	mu       sync.Mutex
+	next     *Rules // règles des prochains combats
+	rules    *Rules // règles du combat en cours
+	battleID string
+	referee  *core.Referee
+	bus      *core.EventBus
+}
+
+// attemptOutcome décrit l'issue d'une tentative après arbitrage
+type attemptOutcome struct {
+	Judgement core.Judgement
+	Verdict   core.Verdict
+	Decided   bool
+	Won       bool
+}
+
+// newBattleBoard crée le tableau des combats selon la config du jeu pour
+// les spawns retournés par current ; les tentatives sont publiées sur bus
+func newBattleBoard(gameConfig *config.GameConfig, current func() *core.Spawn, bus *core.EventBus) *battleBoard {
+	return &battleBoard{
+		current: current,
+		next:    RulesFromConfig(gameConfig, nil),
+		bus:     bus,
+	}
+}
+
+// configure remplace les règles des prochains combats ; le combat en cours
+// se termine avec les siennes
+func (b *battleBoard) configure(rules *Rules) {
+	b.mu.Lock()
+	b.next = rules
+	b.mu.Unlock()
+}
+
+// refereeFor retourne l'arbitre du spawn et les règles de son combat, créés
+// à la première tentative. Un spawn qui n'est plus en jeu (tentative lue
+// avant son remplacement) n'ouvre pas de combat : BattleClosedError.
+func (b *battleBoard) refereeFor(spawn *core.Spawn, settle func(core.Verdict)) (*core.Referee, *Rules, error) {
+	b.mu.Lock()
+	if b.battleID == spawn.BattleID && b.referee != nil {
+		referee, rules := b.referee, b.rules
+		b.mu.Unlock()
+		return referee, rules, nil
+	}
+	if b.current() != spawn {
+		b.mu.Unlock()
+		return nil, nil, core.BattleClosedError{BattleID: spawn.BattleID}
+	}
+
+	previous := b.referee
+	rules := b.next
+	referee, err := core.NewReferee(rules.Arbitration, rules.Timeout, settle)
+	if err != nil {
+		b.mu.Unlock()
+		return nil, nil, err
+	}
+	b.battleID = spawn.BattleID
+	b.referee = referee
+	b.rules = rules
+	b.mu.Unlock()
+
+	// Le spawn précédent a été remplacé : son combat est clos
+	if previous != nil {
+		previous.Close()
+	}
+
+	return referee, rules, nil
+}
+
+// close clôt le combat du spawn (fuite ou remplacement) et rend son verdict ;
+// retourne false si aucune tentative n'avait ouvert de combat
+func (b *battleBoard) close(spawn *core.Spawn) bool {
+	b.mu.Lock()
+	if b.battleID != spawn.BattleID || b.referee == nil {
+		b.mu.Unlock()
+		return false
+	}
+	referee := b.referee
+	b.battleID = ""
+	b.referee = nil
+	b.rules = nil
+	b.mu.Unlock()
+
+	referee.Close()
+	return true
+}
+
+// arbitrate soumet la tentative à l'arbitre du spawn et attend le verdict si
+// la tentative peut encore l'influencer. settle applique le verdict (une seule fois).
+func (b *battleBoard) arbitrate(ctx context.Context, spawn *core.Spawn, playerID, answer string, settle func(core.Verdict)) (attemptOutcome, error) {
+	referee, rules, err := b.refereeFor(spawn, settle)
+	if err != nil {
+		return attemptOutcome{}, err
+	}
+
+	judgement := core.JudgeChallenge(rules.NewChallenge(spawn.Word), core.Attempt{
+		BattleID:    spawn.BattleID,
+		PlayerID:    playerID,
+		Answer:      answer,
+		Word:        spawn.Word,
+		SubmittedAt: time.Now(),
+	})
+	outcome := attemptOutcome{Judgement: judgement}
+
+	b.bus.Publish(core.AttemptMade{
+		BattleID: spawn.BattleID,
+		PlayerID: playerID,
+		Word:     spawn.Word,
+		Answer:   answer,
+		Correct:  judgement.Correct,
+		At:       judgement.At,
+	})
+
+	accepted := referee.Submit(judgement)
+
+	// Mauvaise réponse ignorée par la politique : le combat continue
+	if accepted && !judgement.Correct && !referee.Decided() {
+		return outcome, nil
+	}
+
+	select {
+	case <-referee.Done():
+	case <-ctx.Done():
+		return outcome, ctx.Err()
+	}
+
+	outcome.Decided = true
+	outcome.Verdict = referee.Verdict()
+	outcome.Won = accepted && outcome.Verdict.HasWinner(playerID)
+	return outcome, nil
+}
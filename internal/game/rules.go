@@ -0,0 +1,40 @@
+package game
+
+import (
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// Rules réglages du jeu rechargeables à chaud : apparitions, combats et défis.
+// Une valeur Rules n'est jamais modifiée ; une nouvelle config la remplace
+// d'un bloc (GameService.Configure).
+type Rules struct {
+	Weights     config.RarityWeights
+	Interval    time.Duration // entre deux apparitions
+	Timeout     time.Duration // fuite automatique d'un WordMon
+	Arbitration core.ArbitrationConfig
+	Anagram     core.AnagramRules
+}
+
+// RulesFromConfig construit les règles depuis la config du jeu et celle des
+// défis (nil : règles historiques des défis)
+func RulesFromConfig(gameConfig *config.GameConfig, challenges *config.ChallengesConfig) *Rules {
+	rules := &Rules{
+		Weights:     gameConfig.RarityWeights,
+		Interval:    time.Duration(gameConfig.Spawner.IntervalSeconds) * time.Second,
+		Timeout:     time.Duration(gameConfig.Spawner.AutoFleeAfterSeconds) * time.Second,
+		Arbitration: ArbitrationFromConfig(gameConfig),
+		Anagram:     core.DefaultAnagramRules,
+	}
+	if challenges != nil {
+		rules.Anagram = core.AnagramRules{MustDifferFromSource: challenges.Anagram.MustDifferFromSource}
+	}
+	return rules
+}
+
+// NewChallenge fabrique le défi d'un mot selon les règles
+func (r *Rules) NewChallenge(word core.Word) core.Challenge {
+	return core.NewAnagramChallengeWithRules(word, r.Anagram)
+}
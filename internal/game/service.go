@@ -0,0 +1,343 @@
+// Package game regroupe les règles du jeu communes à tous les transports :
+// apparition des WordMon, arbitrage des combats, captures et XP. HTTP, la CLI
+// et les joueurs IA passent uniquement par GameService.
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+// Erreurs du service
+var (
+	ErrNoSpawn        = errors.New("aucun WordMon actif")
+	ErrPlayerNotFound = errors.New("joueur non trouvé")
+	ErrInvalidName    = errors.New("nom ne peut pas être vide")
+)
+
+// Bornes de la taille d'un classement
+const (
+	DefaultLeaderboardLimit = 10
+	MaxLeaderboardLimit     = 50
+	MaxRankNeighbors        = 10 // voisins de part et d'autre d'un joueur
+)
+
+// LeaderboardLimit borne la taille demandée d'un classement (défaut si < 1)
+func LeaderboardLimit(limit int) int {
+	if limit < 1 {
+		return DefaultLeaderboardLimit
+	}
+	if limit > MaxLeaderboardLimit {
+		return MaxLeaderboardLimit
+	}
+	return limit
+}
+
+// AttemptStatus issue d'une tentative pour le joueur
+type AttemptStatus string
+
+const (
+	StatusCaptured AttemptStatus = "captured" // le joueur capture le WordMon
+	StatusWrong    AttemptStatus = "wrong"    // mauvaise réponse ignorée, le WordMon reste en jeu
+	StatusMissed   AttemptStatus = "missed"   // capturé par un autre joueur
+	StatusFled     AttemptStatus = "fled"     // le WordMon s'enfuit
+)
+
+// AttemptResult résultat typé d'une tentative de capture
+type AttemptResult struct {
+	Status   AttemptStatus
+	SpawnID  string
+	Word     core.Word
+	Correct  bool
+	Reason   string
+	XPGained int
+	Player   core.Player // état du joueur après la tentative
+}
+
+// GameService applique les règles du jeu sur un store
+type GameService struct {
+	store   store.Store
+	bus     *core.EventBus
+	spawner *Spawner
+	battles *battleBoard
+}
+
+// eventPublisher est implémenté par les stores qui publient leurs événements
+type eventPublisher interface {
+	SetEventBus(bus *core.EventBus)
+}
+
+// NewGameService crée le service de jeu ; ses événements et ceux du store sont
+// publiés sur bus (un bus local est créé si nil)
+func NewGameService(s store.Store, gameConfig *config.GameConfig, bus *core.EventBus) *GameService {
+	if bus == nil {
+		bus = core.NewEventBus()
+	}
+	if publisher, ok := s.(eventPublisher); ok {
+		publisher.SetEventBus(bus)
+	}
+
+	spawner := NewSpawner(s, gameConfig)
+	service := &GameService{
+		store:   s,
+		bus:     bus,
+		spawner: spawner,
+		battles: newBattleBoard(gameConfig, spawner.Current, bus),
+	}
+
+	// Un WordMon qui s'enfuit clôt son combat
+	service.spawner.OnSpawn(service.announceSpawn)
+	service.spawner.OnFlee(service.retireSpawn)
+
+	return service
+}
+
+// Configure applique de nouvelles règles aux apparitions et aux combats
+// suivants ; le combat en cours se termine avec les siennes
+func (s *GameService) Configure(rules *Rules) {
+	s.battles.configure(rules)
+	s.spawner.Configure(rules)
+}
+
+// Reload applique une config rechargée : règles du jeu, et catalogue des mots
+// du store (points selon xpRewards) seulement si server.reloadSyncWords ;
+// sinon le catalogue est comparé sans être modifié. Si le catalogue est
+// refusé, rien n'est modifié. Retourne la synchronisation ou le plan du
+// catalogue (nil si le store n'en gère pas).
+func (s *GameService) Reload(ctx context.Context, gameConfig *config.GameConfig, wordsConfig *config.WordsConfig, challenges *config.ChallengesConfig) (*store.CatalogSync, error) {
+	var sync *store.CatalogSync
+	if catalog, ok := s.store.(store.CatalogStore); ok {
+		synced, err := catalog.SyncWords(ctx, WordsFromConfig(wordsConfig, gameConfig), gameConfig.Server.ReloadSyncWords)
+		if err != nil {
+			return nil, fmt.Errorf("erreur synchronisation catalogue: %w", err)
+		}
+		sync = synced
+	}
+	s.Configure(RulesFromConfig(gameConfig, challenges))
+	return sync, nil
+}
+
+// Bus retourne le bus d'événements du service
+func (s *GameService) Bus() *core.EventBus {
+	return s.bus
+}
+
+// Start fait apparaître les WordMon jusqu'à l'annulation du contexte (bloquant)
+func (s *GameService) Start(ctx context.Context) {
+	s.spawner.Start(ctx)
+}
+
+// CurrentSpawn retourne le WordMon actuel (nil si aucun)
+func (s *GameService) CurrentSpawn() *core.Spawn {
+	return s.spawner.Current()
+}
+
+// PlaceSpawn fait apparaître word immédiatement, sans fuite programmée
+func (s *GameService) PlaceSpawn(word core.Word) *core.Spawn {
+	return s.spawner.Place(word)
+}
+
+// CreatePlayer inscrit un joueur ; le nom est unique
+func (s *GameService) CreatePlayer(ctx context.Context, name string) (*core.Player, error) {
+	return s.CreatePlayerInTeam(ctx, name, "")
+}
+
+// CreatePlayerInTeam inscrit un joueur dans une équipe ("" : aucune)
+func (s *GameService) CreatePlayerInTeam(ctx context.Context, name, team string) (*core.Player, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	// Équipe vérifiée avant l'inscription : pas de joueur créé à moitié
+	team = strings.TrimSpace(team)
+	if err := store.ValidateTeam(team); err != nil {
+		return nil, err
+	}
+
+	player, err := s.store.Create(ctx, name)
+	if err != nil || team == "" {
+		return player, err
+	}
+	if err := s.store.SetTeam(ctx, player.ID, team); err != nil {
+		return nil, err
+	}
+	player.Team = team
+	return player, nil
+}
+
+// Player retourne un joueur et son inventaire
+func (s *GameService) Player(ctx context.Context, id string) (*core.Player, error) {
+	player, err := s.store.Get(ctx, id)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrPlayerNotFound, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// SetPlayerXP fixe l'XP d'un joueur (outil du maître du jeu) ; le niveau suit
+func (s *GameService) SetPlayerXP(ctx context.Context, id string, xp int) (*core.Player, error) {
+	if err := s.store.UpdateXP(ctx, id, xp, core.LevelFromXP(xp)); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrPlayerNotFound, err)
+		}
+		return nil, err
+	}
+	return s.Player(ctx, id)
+}
+
+// DeletePlayer supprime un joueur, ses captures et ses jetons
+func (s *GameService) DeletePlayer(ctx context.Context, id string) error {
+	if err := s.store.Delete(ctx, id); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("%w: %w", ErrPlayerNotFound, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Leaderboard retourne les joueurs par XP décroissant (tous si limit <= 0)
+func (s *GameService) Leaderboard(ctx context.Context, limit int) ([]core.Player, error) {
+	return s.store.List(ctx, limit)
+}
+
+// Ranking retourne une page du classement filtré ; la limite est bornée
+// comme celle de Leaderboard
+func (s *GameService) Ranking(ctx context.Context, q store.LeaderboardQuery) (*store.LeaderboardPage, error) {
+	q.Limit = LeaderboardLimit(q.Limit)
+	return s.store.Leaderboard(ctx, q)
+}
+
+// PlayerRank retourne le rang, le percentile et les voisins d'un joueur
+// dans le classement filtré
+func (s *GameService) PlayerRank(ctx context.Context, id string, q store.LeaderboardQuery, neighbors int) (*store.PlayerRank, error) {
+	if neighbors < 0 {
+		neighbors = 0
+	}
+	if neighbors > MaxRankNeighbors {
+		neighbors = MaxRankNeighbors
+	}
+
+	rank, err := s.store.PlayerRank(ctx, id, q, neighbors)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrPlayerNotFound, err)
+	}
+	return rank, err
+}
+
+// Attempt soumet la réponse du joueur au combat du spawn spawnID (le spawn
+// actuel si vide) et attend l'issue si la tentative peut encore l'influencer
+func (s *GameService) Attempt(ctx context.Context, playerID, spawnID, answer string) (AttemptResult, error) {
+	if strings.TrimSpace(answer) == "" {
+		return AttemptResult{}, core.InvalidAttemptError{Input: answer, Reason: "entrée vide"}
+	}
+
+	player, err := s.Player(ctx, playerID)
+	if err != nil {
+		return AttemptResult{}, err
+	}
+
+	spawn := s.spawner.Current()
+	if spawn == nil {
+		return AttemptResult{}, ErrNoSpawn
+	}
+	if spawnID != "" && spawnID != spawn.BattleID {
+		return AttemptResult{}, core.BattleClosedError{BattleID: spawnID}
+	}
+
+	outcome, err := s.battles.arbitrate(ctx, spawn, playerID, answer,
+		func(verdict core.Verdict) { s.settleBattle(spawn, verdict) })
+	if err != nil {
+		return AttemptResult{}, err
+	}
+
+	result := AttemptResult{
+		SpawnID: spawn.BattleID,
+		Word:    spawn.Word,
+		Correct: outcome.Judgement.Correct,
+	}
+
+	switch {
+	case outcome.Won:
+		result.Status = StatusCaptured
+		result.XPGained = spawn.Word.Points
+	case !outcome.Decided:
+		result.Status = StatusWrong
+		result.Reason = core.VerdictWrongAttempt
+	case outcome.Verdict.Reason == core.VerdictCaptured:
+		result.Status = StatusMissed
+		result.Reason = "captured by another player"
+	default:
+		result.Status = StatusFled
+		result.Reason = outcome.Verdict.Reason
+	}
+
+	// État du joueur après le verdict (XP créditée par le store)
+	result.Player = *player
+	if updated, err := s.store.Get(ctx, playerID); err == nil {
+		result.Player = *updated
+	}
+
+	return result, nil
+}
+
+// Penalize retire jusqu'à cost XP au joueur (sans descendre sous zéro) et
+// retourne l'XP effectivement retirée
+func (s *GameService) Penalize(ctx context.Context, playerID string, cost int) (int, error) {
+	if cost <= 0 {
+		return 0, nil
+	}
+
+	// Lecture et écriture en une opération du store : une capture créditée
+	// entre-temps n'est pas écrasée
+	applied, err := s.store.AddXP(ctx, playerID, -cost)
+	if errors.Is(err, store.ErrNotFound) {
+		return 0, fmt.Errorf("%w: %w", ErrPlayerNotFound, err)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("erreur pénalité XP: %w", err)
+	}
+	return -applied, nil
+}
+
+// settleBattle applique le verdict d'un combat : capture pour les gagnants puis fin du spawn
+func (s *GameService) settleBattle(spawn *core.Spawn, verdict core.Verdict) {
+	for _, winner := range verdict.Winners {
+		// Le store crédite l'XP et publie la capture sur le bus ; la capture
+		// est enregistrée même si la requête du gagnant a été abandonnée
+		if err := s.store.Add(context.Background(), winner.Attempt.PlayerID, spawn.Word.ID); err != nil {
+			fmt.Printf("[game] Erreur enregistrement capture: %v\n", err)
+		}
+	}
+
+	if verdict.Reason != core.VerdictCaptured {
+		s.bus.Publish(core.SpawnFled{BattleID: spawn.BattleID, Word: spawn.Word, Reason: verdict.Reason, At: time.Now().UTC()})
+	}
+
+	// Le WordMon est capturé ou s'enfuit : supprimer le spawn
+	s.spawner.Clear(spawn)
+}
+
+// announceSpawn publie l'apparition d'un WordMon
+func (s *GameService) announceSpawn(spawn *core.Spawn) {
+	s.bus.Publish(core.SpawnCreated{BattleID: spawn.BattleID, Word: spawn.Word, At: time.Now().UTC()})
+}
+
+// retireSpawn clôt le combat d'un WordMon qui s'enfuit
+func (s *GameService) retireSpawn(spawn *core.Spawn) {
+	// Si un combat était ouvert, son verdict publie déjà l'issue
+	if s.battles.close(spawn) {
+		return
+	}
+	s.bus.Publish(core.SpawnFled{BattleID: spawn.BattleID, Word: spawn.Word, Reason: core.VerdictTimeout, At: time.Now().UTC()})
+}
@@ -0,0 +1,176 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+// newTestService crée un service mémoire avec un joueur inscrit
+func newTestService(t *testing.T) (*GameService, *core.Player, core.Word) {
+	t.Helper()
+	ctx := t.Context()
+	gameConfig := &config.GameConfig{
+		Spawner: config.SpawnerConfig{AutoFleeAfterSeconds: 5},
+		Battle:  config.BattleConfig{Policy: "first-correct-wins"},
+	}
+	memStore := store.NewMemoryStore()
+	word := core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}
+	memStore.Seed(ctx, []core.Word{word})
+
+	service := NewGameService(memStore, gameConfig, nil)
+	player, err := service.CreatePlayer(context.Background(), "Alice")
+	if err != nil {
+		t.Fatalf("création joueur: %v", err)
+	}
+	return service, player, word
+}
+
+func TestAttemptCapturesAndCreditsPlayer(t *testing.T) {
+	service, player, word := newTestService(t)
+	spawn := service.PlaceSpawn(word)
+
+	result, err := service.Attempt(context.Background(), player.ID, spawn.BattleID, "tach")
+	if err != nil {
+		t.Fatalf("tentative: %v", err)
+	}
+	if result.Status != StatusCaptured || result.XPGained != 5 {
+		t.Fatalf("capture attendue: %+v", result)
+	}
+	if result.Player.XP != 5 || result.Player.Inventory["c_1"] != 1 {
+		t.Errorf("joueur non crédité: %+v", result.Player)
+	}
+	if service.CurrentSpawn() != nil {
+		t.Error("spawn retiré attendu après capture")
+	}
+}
+
+func TestAttemptWrongAnswerKeepsSpawn(t *testing.T) {
+	service, player, word := newTestService(t)
+	spawn := service.PlaceSpawn(word)
+
+	result, err := service.Attempt(context.Background(), player.ID, spawn.BattleID, "xxxx")
+	if err != nil {
+		t.Fatalf("tentative: %v", err)
+	}
+	if result.Status != StatusWrong || result.Correct {
+		t.Errorf("mauvaise réponse attendue: %+v", result)
+	}
+	if service.CurrentSpawn() != spawn {
+		t.Error("le WordMon doit rester en jeu")
+	}
+}
+
+func TestAttemptRejectsStaleSpawn(t *testing.T) {
+	service, player, word := newTestService(t)
+	stale := service.PlaceSpawn(word)
+	service.PlaceSpawn(word)
+
+	_, err := service.Attempt(context.Background(), player.ID, stale.BattleID, "tach")
+	var closed core.BattleClosedError
+	if !errors.As(err, &closed) || closed.BattleID != stale.BattleID {
+		t.Errorf("combat clos attendu, obtenu %v", err)
+	}
+}
+
+// Une tentative qui a lu le spawn N avant son remplacement arrive alors que
+// le combat du spawn N+1 est déjà ouvert : elle ne doit ni le clore ni
+// rouvrir un combat pour N
+func TestStaleAttemptKeepsNewerBattle(t *testing.T) {
+	service, player, word := newTestService(t)
+	ctx := context.Background()
+	stale := service.PlaceSpawn(word)
+	current := service.PlaceSpawn(word)
+
+	if result, err := service.Attempt(ctx, player.ID, current.BattleID, "xxxx"); err != nil || result.Status != StatusWrong {
+		t.Fatalf("combat de %s ouvert attendu: %+v (%v)", current.BattleID, result, err)
+	}
+
+	// Suite de Attempt après service.spawner.Current() == stale
+	_, err := service.battles.arbitrate(ctx, stale, player.ID, "tach",
+		func(verdict core.Verdict) { service.settleBattle(stale, verdict) })
+	var closed core.BattleClosedError
+	if !errors.As(err, &closed) || closed.BattleID != stale.BattleID {
+		t.Fatalf("combat clos attendu pour %s, obtenu %v", stale.BattleID, err)
+	}
+
+	if service.CurrentSpawn() != current {
+		t.Fatal("le WordMon du combat en cours ne doit pas s'enfuir")
+	}
+	result, err := service.Attempt(ctx, player.ID, current.BattleID, "tach")
+	if err != nil || result.Status != StatusCaptured {
+		t.Fatalf("capture de %s attendue: %+v (%v)", current.BattleID, result, err)
+	}
+	if result.Player.XP != word.Points || result.Player.Inventory[word.ID] != 1 {
+		t.Errorf("une seule capture attendue: %+v", result.Player)
+	}
+}
+
+func TestAttemptErrors(t *testing.T) {
+	service, player, word := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := service.Attempt(ctx, player.ID, "", "tach"); !errors.Is(err, ErrNoSpawn) {
+		t.Errorf("aucun spawn attendu, obtenu %v", err)
+	}
+
+	service.PlaceSpawn(word)
+	if _, err := service.Attempt(ctx, "inconnu", "", "tach"); !errors.Is(err, ErrPlayerNotFound) {
+		t.Errorf("joueur inconnu attendu, obtenu %v", err)
+	}
+	var invalid core.InvalidAttemptError
+	if _, err := service.Attempt(ctx, player.ID, "", "  "); !errors.As(err, &invalid) {
+		t.Errorf("tentative invalide attendue, obtenu %v", err)
+	}
+}
+
+func TestReloadAppliesCatalogAndRules(t *testing.T) {
+	service, player, _ := newTestService(t)
+	ctx := context.Background()
+
+	gameConfig := &config.GameConfig{
+		Server:    config.ServerConfig{ReloadSyncWords: true},
+		XPRewards: config.XPRewards{Common: 7, Rare: 20, Legendary: 50},
+		Spawner:   config.SpawnerConfig{AutoFleeAfterSeconds: 5},
+		Battle:    config.BattleConfig{Policy: "first-correct-wins"},
+	}
+	wordsConfig := &config.WordsConfig{Words: []config.WordEntry{{ID: "c_1", Text: "chat", Rarity: "Common"}}}
+	challenges := &config.ChallengesConfig{Anagram: config.AnagramConfig{MustDifferFromSource: false}}
+
+	// Sans server.reloadSyncWords : plan seulement, catalogue inchangé
+	planOnly := *gameConfig
+	planOnly.Server.ReloadSyncWords = false
+	plan, err := service.Reload(ctx, &planOnly, wordsConfig, challenges)
+	if err != nil || plan == nil || plan.Applied || len(plan.Updated) != 1 {
+		t.Fatalf("plan sans application attendu: %+v (%v)", plan, err)
+	}
+	if word, _ := service.store.GetWord(ctx, "c_1"); word.Points == 7 {
+		t.Error("catalogue modifié sans server.reloadSyncWords")
+	}
+
+	sync, err := service.Reload(ctx, gameConfig, wordsConfig, challenges)
+	if err != nil || sync == nil || !sync.Applied || len(sync.Updated) != 1 {
+		t.Fatalf("points de c_1 mis à jour attendus: %+v (%v)", sync, err)
+	}
+
+	// Le mot d'origine est désormais accepté et rapporte les nouveaux points
+	word, _ := service.store.GetWord(ctx, "c_1")
+	spawn := service.PlaceSpawn(*word)
+	result, err := service.Attempt(ctx, player.ID, spawn.BattleID, "chat")
+	if err != nil || result.Status != StatusCaptured || result.Player.XP != 7 {
+		t.Errorf("capture à 7 XP attendue: %+v (%v)", result, err)
+	}
+
+	// Catalogue refusé : règles inchangées
+	if _, err := service.Reload(ctx, gameConfig, &config.WordsConfig{}, &config.ChallengesConfig{}); err == nil {
+		t.Fatal("catalogue vide: erreur attendue")
+	}
+	spawn = service.PlaceSpawn(*word)
+	if result, _ := service.Attempt(ctx, player.ID, spawn.BattleID, "chat"); result.Status != StatusCaptured {
+		t.Errorf("les règles précédentes doivent rester en place: %+v", result)
+	}
+}
@@ -0,0 +1,178 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+)
+
+// Spawner fait apparaître les WordMon du catalogue du store et gère leur
+// fuite automatique. Chaque apparition ouvre un combat identifié par son BattleID.
+type Spawner struct {
+	words   store.WordStore
+	rules   atomic.Pointer[Rules]
+	changed chan struct{} // signale un nouvel intervalle à Start
+
+	mu      sync.RWMutex
+	current *core.Spawn
+	nextID  int
+	onSpawn func(spawn *core.Spawn)
+	onFlee  func(spawn *core.Spawn)
+}
+
+// NewSpawner crée un spawner selon la config du jeu
+func NewSpawner(words store.WordStore, gameConfig *config.GameConfig) *Spawner {
+	s := &Spawner{words: words, changed: make(chan struct{}, 1), nextID: 1}
+	s.rules.Store(RulesFromConfig(gameConfig, nil))
+	return s
+}
+
+// Configure remplace les règles des prochaines apparitions ; le WordMon
+// actuel garde sa fuite programmée
+func (s *Spawner) Configure(rules *Rules) {
+	s.rules.Store(rules)
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+// OnSpawn enregistre la fonction appelée à chaque apparition de WordMon
+func (s *Spawner) OnSpawn(fn func(spawn *core.Spawn)) {
+	s.onSpawn = fn
+}
+
+// OnFlee enregistre la fonction appelée quand un WordMon s'enfuit (timeout)
+func (s *Spawner) OnFlee(fn func(spawn *core.Spawn)) {
+	s.onFlee = fn
+}
+
+// Start fait apparaître un premier WordMon puis un nouveau à chaque intervalle
+// si aucun n'est actif, jusqu'à l'annulation du contexte
+func (s *Spawner) Start(ctx context.Context) {
+	rules := s.rules.Load()
+	interval := rules.Interval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	fmt.Printf("[spawn] Spawner démarré - intervalle: %v, timeout: %v\n", interval, rules.Timeout)
+	s.spawnWordMon(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println("[spawn] Spawner arrêté")
+			return
+		case <-ticker.C:
+			s.spawnWordMon(ctx)
+		case <-s.changed:
+			if next := s.rules.Load().Interval; next != interval {
+				interval = next
+				ticker.Reset(interval)
+				fmt.Printf("[spawn] Nouvel intervalle: %v\n", interval)
+			}
+		}
+	}
+}
+
+// Current retourne le spawn actuel (nil si aucun)
+func (s *Spawner) Current() *core.Spawn {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current
+}
+
+// Place fait apparaître word sans fuite programmée et retourne son spawn
+func (s *Spawner) Place(word core.Word) *core.Spawn {
+	s.mu.Lock()
+	spawn := &core.Spawn{BattleID: fmt.Sprintf("b%d", s.nextID), Word: word}
+	s.nextID++
+	s.current = spawn
+	s.mu.Unlock()
+
+	if s.onSpawn != nil {
+		s.onSpawn(spawn)
+	}
+	return spawn
+}
+
+// Clear retire spawn s'il est toujours le spawn actuel ;
+// retourne false s'il a déjà été remplacé ou retiré
+func (s *Spawner) Clear(spawn *core.Spawn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current != spawn {
+		return false
+	}
+	s.current = nil
+	return true
+}
+
+// spawnWordMon génère un nouveau WordMon si aucun n'est actif
+func (s *Spawner) spawnWordMon(ctx context.Context) {
+	if s.Current() != nil {
+		return
+	}
+
+	rules := s.rules.Load()
+	word, err := s.words.RandomByRarity(ctx, string(selectRarity(rules.Weights)))
+	if err != nil {
+		fmt.Printf("[spawn] Erreur sélection mot: %v\n", err)
+		return
+	}
+
+	spawn := s.Place(*word)
+
+	fmt.Printf("[spawn] Nouveau WordMon: \"%s\" (%s, %d points) [combat %s]\n",
+		word.Text, word.Rarity, word.Points, spawn.BattleID)
+
+	// Programmer la fuite automatique après timeout
+	go s.scheduleAutoFlee(ctx, spawn, rules.Timeout)
+}
+
+// selectRarity sélectionne une rareté selon les poids configurés
+func selectRarity(weights config.RarityWeights) core.Rarity {
+	total := weights.Common + weights.Rare + weights.Legendary
+	if total <= 0 {
+		return core.Common
+	}
+
+	roll := rand.Intn(total)
+	switch {
+	case roll < weights.Common:
+		return core.Common
+	case roll < weights.Common+weights.Rare:
+		return core.Rare
+	default:
+		return core.Legendary
+	}
+}
+
+// scheduleAutoFlee fait fuir le WordMon s'il est toujours actif après timeout
+func (s *Spawner) scheduleAutoFlee(ctx context.Context, spawn *core.Spawn, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-timer.C:
+	}
+
+	if !s.Clear(spawn) {
+		return
+	}
+	fmt.Printf("[spawn] \"%s\" s'est enfui (timeout)\n", spawn.Word.Text)
+
+	if s.onFlee != nil {
+		s.onFlee(spawn)
+	}
+}
@@ -0,0 +1,26 @@
+package game
+
+import (
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// WordsFromConfig convertit le dictionnaire de la config en mots du catalogue,
+// avec les points de la rareté selon xpRewards
+func WordsFromConfig(wordsConfig *config.WordsConfig, gameConfig *config.GameConfig) []core.Word {
+	words := make([]core.Word, len(wordsConfig.Words))
+	for i, entry := range wordsConfig.Words {
+		rarity := core.ParseRarity(entry.Rarity)
+
+		points := gameConfig.XPRewards.Common
+		switch rarity {
+		case core.Rare:
+			points = gameConfig.XPRewards.Rare
+		case core.Legendary:
+			points = gameConfig.XPRewards.Legendary
+		}
+
+		words[i] = core.Word{ID: entry.ID, Text: entry.Text, Rarity: rarity, Points: points}
+	}
+	return words
+}
@@ -0,0 +1,353 @@
+// Package grpcapi expose le service de jeu en gRPC pour les outils du maître
+// du jeu. Il partage le service (donc le store et le spawn courant) de l'API REST.
+package grpcapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/game"
+	"github.com/SamG1008/wordmon-go/internal/grpcapi/wordmonpb"
+	"github.com/SamG1008/wordmon-go/internal/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server implémente wordmonpb.GameMasterServer sur le service de jeu
+type Server struct {
+	wordmonpb.UnimplementedGameMasterServer
+	game *game.GameService
+}
+
+// NewServer crée l'implémentation gRPC du service de jeu
+func NewServer(g *game.GameService) *Server {
+	return &Server{game: g}
+}
+
+// NewGRPCServer crée un serveur gRPC protégé par le jeton admin. Sans jeton
+// configuré, toutes les méthodes sont refusées (comme /admin en REST).
+func NewGRPCServer(g *game.GameService, adminToken string) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := authorize(ctx, adminToken); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorize(ss.Context(), adminToken); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+	wordmonpb.RegisterGameMasterServer(srv, NewServer(g))
+	return srv
+}
+
+// Serve écoute sur port jusqu'à l'annulation du contexte
+func Serve(ctx context.Context, srv *grpc.Server, port string) error {
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return fmt.Errorf("erreur écoute gRPC: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+
+	return srv.Serve(listener)
+}
+
+// Shutdown arrête srv : plus de nouveaux appels, ceux en cours se terminent.
+// À l'échéance de ctx, les appels restants (flux WatchSpawns) sont interrompus.
+func Shutdown(ctx context.Context, srv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		srv.Stop()
+		<-stopped
+	}
+}
+
+// authorize vérifie la métadonnée "authorization: Bearer <jeton admin>"
+func authorize(ctx context.Context, adminToken string) error {
+	if adminToken == "" {
+		return status.Error(codes.Unavailable, "administration désactivée (WORDMON_ADMIN_TOKEN non défini)")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var given string
+	if values := md.Get("authorization"); len(values) > 0 {
+		given = strings.TrimPrefix(values[0], "Bearer ")
+	}
+	if subtle.ConstantTimeCompare([]byte(given), []byte(adminToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "jeton admin invalide")
+	}
+	return nil
+}
+
+// === JOUEURS ===
+
+// CreatePlayer inscrit un joueur
+func (s *Server) CreatePlayer(ctx context.Context, req *wordmonpb.CreatePlayerRequest) (*wordmonpb.Player, error) {
+	player, err := s.game.CreatePlayer(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return playerToPB(player), nil
+}
+
+// GetPlayer retourne un joueur et son inventaire
+func (s *Server) GetPlayer(ctx context.Context, req *wordmonpb.GetPlayerRequest) (*wordmonpb.Player, error) {
+	player, err := s.game.Player(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return playerToPB(player), nil
+}
+
+// SetPlayerXP fixe l'XP d'un joueur
+func (s *Server) SetPlayerXP(ctx context.Context, req *wordmonpb.SetPlayerXPRequest) (*wordmonpb.Player, error) {
+	player, err := s.game.SetPlayerXP(ctx, req.GetId(), int(req.GetXp()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return playerToPB(player), nil
+}
+
+// DeletePlayer supprime un joueur
+func (s *Server) DeletePlayer(ctx context.Context, req *wordmonpb.DeletePlayerRequest) (*wordmonpb.DeletePlayerResponse, error) {
+	if err := s.game.DeletePlayer(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &wordmonpb.DeletePlayerResponse{}, nil
+}
+
+// === JEU ===
+
+// WatchSpawns envoie le WordMon actuel puis chaque apparition, fuite ou capture
+func (s *Server) WatchSpawns(_ *wordmonpb.WatchSpawnsRequest, stream grpc.ServerStreamingServer[wordmonpb.SpawnEvent]) error {
+	events := make(chan core.DomainEvent, 64)
+	unsubscribe := s.game.Bus().Subscribe(func(event core.DomainEvent) {
+		select {
+		case events <- event:
+		default:
+			// Client trop lent : l'événement est perdu pour lui
+		}
+	}, core.EventSpawnCreated, core.EventSpawnFled, core.EventWordCaptured)
+	defer unsubscribe()
+
+	current := s.game.CurrentSpawn()
+	if current != nil {
+		if err := stream.Send(spawnEvent(wordmonpb.SpawnEvent_KIND_SPAWNED, current, time.Now())); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			var out *wordmonpb.SpawnEvent
+			switch e := event.(type) {
+			case core.SpawnCreated:
+				current = &core.Spawn{BattleID: e.BattleID, Word: e.Word}
+				out = spawnEvent(wordmonpb.SpawnEvent_KIND_SPAWNED, current, e.At)
+			case core.SpawnFled:
+				out = spawnEvent(wordmonpb.SpawnEvent_KIND_FLED, &core.Spawn{BattleID: e.BattleID, Word: e.Word}, e.At)
+				out.Reason = e.Reason
+			case core.WordCaptured:
+				// La capture concerne le WordMon suivi par ce flux
+				if current == nil || current.Word.ID != e.Word.ID {
+					continue
+				}
+				out = spawnEvent(wordmonpb.SpawnEvent_KIND_CAPTURED, current, e.At)
+				out.PlayerId = e.PlayerID
+			default:
+				continue
+			}
+			if err := stream.Send(out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubmitAttempt soumet une tentative pour le compte d'un joueur
+func (s *Server) SubmitAttempt(ctx context.Context, req *wordmonpb.SubmitAttemptRequest) (*wordmonpb.AttemptResult, error) {
+	result, err := s.game.Attempt(ctx, req.GetPlayerId(), req.GetSpawnId(), req.GetAnswer())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &wordmonpb.AttemptResult{
+		Status:   string(result.Status),
+		SpawnId:  result.SpawnID,
+		Word:     wordToPB(result.Word),
+		Correct:  result.Correct,
+		Reason:   result.Reason,
+		XpGained: int32(result.XPGained),
+		Player:   playerToPB(&result.Player),
+	}, nil
+}
+
+// GetLeaderboard retourne une page du classement (mêmes critères, filtres et
+// bornes que GET /leaderboard)
+func (s *Server) GetLeaderboard(ctx context.Context, req *wordmonpb.LeaderboardRequest) (*wordmonpb.LeaderboardResponse, error) {
+	query, err := leaderboardQuery(req.GetBy(), req.GetTeam(), req.GetMinLevel(), req.GetMaxLevel())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if cursor := req.GetCursor(); cursor != "" {
+		if query.After, err = store.ParseCursor(cursor); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	query.Limit = int(req.GetLimit())
+
+	page, err := s.game.Ranking(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &wordmonpb.LeaderboardResponse{Players: rankedToPB(page.Players), Total: int32(page.Total)}
+	if page.Next != nil {
+		response.NextCursor = page.Next.String()
+	}
+	return response, nil
+}
+
+// GetPlayerRank retourne le rang, le percentile et les voisins d'un joueur
+// (comme GET /players/{id}/rank)
+func (s *Server) GetPlayerRank(ctx context.Context, req *wordmonpb.PlayerRankRequest) (*wordmonpb.PlayerRank, error) {
+	query, err := leaderboardQuery(req.GetBy(), req.GetTeam(), req.GetMinLevel(), req.GetMaxLevel())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	neighbors := 1
+	if req.Neighbors != nil {
+		neighbors = int(req.GetNeighbors())
+	}
+
+	rank, err := s.game.PlayerRank(ctx, req.GetId(), query, neighbors)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &wordmonpb.PlayerRank{
+		Player:     rankedToPB([]store.RankedPlayer{rank.RankedPlayer})[0],
+		By:         string(query.By),
+		Total:      int32(rank.Total),
+		Percentile: rank.Percentile,
+		Above:      rankedToPB(rank.Above),
+		Below:      rankedToPB(rank.Below),
+	}, nil
+}
+
+// leaderboardQuery valide le critère et les filtres communs au classement et
+// au rang d'un joueur
+func leaderboardQuery(by, team string, minLevel, maxLevel int32) (store.LeaderboardQuery, error) {
+	rankBy, err := store.ParseRankBy(by)
+	if err != nil {
+		return store.LeaderboardQuery{}, err
+	}
+	query := store.LeaderboardQuery{By: rankBy, Team: team, MinLevel: int(minLevel), MaxLevel: int(maxLevel)}
+	if err := query.Validate(); err != nil {
+		return store.LeaderboardQuery{}, err
+	}
+	return query, nil
+}
+
+// === CONVERSIONS ===
+
+// toStatus associe une erreur du jeu ou du store à son code gRPC
+func toStatus(err error) error {
+	var attempt core.InvalidAttemptError
+	var closed core.BattleClosedError
+
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.As(err, &attempt), errors.Is(err, game.ErrInvalidName), errors.Is(err, store.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, game.ErrPlayerNotFound), errors.Is(err, game.ErrNoSpawn), errors.Is(err, store.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &closed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, store.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, store.ErrUnavailable):
+		return status.Error(codes.Unavailable, store.ErrUnavailable.Error())
+	default:
+		return status.Error(codes.Internal, "erreur interne")
+	}
+}
+
+// playerToPB convertit un core.Player
+func playerToPB(player *core.Player) *wordmonpb.Player {
+	out := &wordmonpb.Player{
+		Id:    player.ID,
+		Name:  player.Name,
+		Xp:    int32(player.XP),
+		Level: int32(player.Level),
+		Team:  player.Team,
+	}
+	if len(player.Inventory) > 0 {
+		out.Inventory = make(map[string]int32, len(player.Inventory))
+		for wordID, count := range player.Inventory {
+			out.Inventory[wordID] = int32(count)
+		}
+	}
+	return out
+}
+
+// rankedToPB convertit des joueurs classés (sans inventaire, comme en REST)
+func rankedToPB(players []store.RankedPlayer) []*wordmonpb.Player {
+	out := make([]*wordmonpb.Player, len(players))
+	for i, player := range players {
+		out[i] = &wordmonpb.Player{
+			Id:    player.ID,
+			Name:  player.Name,
+			Xp:    int32(player.XP),
+			Level: int32(player.Level),
+			Team:  player.Team,
+			Rank:  int32(player.Rank),
+			Score: int32(player.Score),
+		}
+	}
+	return out
+}
+
+// wordToPB convertit un core.Word
+func wordToPB(word core.Word) *wordmonpb.Word {
+	return &wordmonpb.Word{
+		Id:     word.ID,
+		Text:   word.Text,
+		Rarity: string(word.Rarity),
+		Points: int32(word.Points),
+	}
+}
+
+// spawnEvent construit un événement du flux des apparitions
+func spawnEvent(kind wordmonpb.SpawnEvent_Kind, spawn *core.Spawn, at time.Time) *wordmonpb.SpawnEvent {
+	return &wordmonpb.SpawnEvent{
+		Kind:     kind,
+		Spawn:    &wordmonpb.Spawn{SpawnId: spawn.BattleID, Word: wordToPB(spawn.Word)},
+		AtUnixMs: at.UnixMilli(),
+	}
+}
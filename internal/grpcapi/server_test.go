@@ -0,0 +1,266 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/api"
+	"github.com/SamG1008/wordmon-go/internal/config"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/grpcapi/wordmonpb"
+	"github.com/SamG1008/wordmon-go/internal/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testAdminToken = "admin-test"
+
+var testWord = core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}
+
+// newTestServers démarre l'API REST et le serveur gRPC sur le même service de jeu
+func newTestServers(t *testing.T) (*api.Server, wordmonpb.GameMasterClient) {
+	t.Helper()
+	ctx := t.Context()
+	gameConfig := &config.GameConfig{
+		Spawner: config.SpawnerConfig{AutoFleeAfterSeconds: 5},
+		Battle:  config.BattleConfig{Policy: "first-attempt-wins"},
+		Auth:    config.AuthConfig{Secret: "test"},
+		Admin:   config.AdminConfig{Token: testAdminToken},
+	}
+	memStore := store.NewMemoryStore()
+	memStore.Seed(ctx, []core.Word{testWord})
+	rest, err := api.NewServer(memStore, gameConfig, nil)
+	if err != nil {
+		t.Fatalf("serveur: %v", err)
+	}
+	rest.Game().PlaceSpawn(testWord)
+
+	listener := bufconn.Listen(1 << 20)
+	srv := NewGRPCServer(rest.Game(), testAdminToken)
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("connexion gRPC: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return rest, wordmonpb.NewGameMasterClient(conn)
+}
+
+// adminContext ajoute le jeton admin aux métadonnées sortantes
+func adminContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+testAdminToken)
+}
+
+// getJSON lit une route REST et décode sa réponse
+func getJSON(t *testing.T, rest *api.Server, path string, out any) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	rest.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET %s: %d %s", path, w.Code, w.Body.String())
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+		t.Fatalf("GET %s: %v", path, err)
+	}
+}
+
+func TestGRPCAndRESTShareGameState(t *testing.T) {
+	rest, client := newTestServers(t)
+	ctx := adminContext(t)
+
+	alice, err := client.CreatePlayer(ctx, &wordmonpb.CreatePlayerRequest{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreatePlayer: %v", err)
+	}
+
+	var spawn api.SpawnJSON
+	getJSON(t, rest, "/v1/spawn/current", &spawn)
+	result, err := client.SubmitAttempt(ctx, &wordmonpb.SubmitAttemptRequest{PlayerId: alice.Id, SpawnId: spawn.SpawnID, Answer: "tach"})
+	if err != nil {
+		t.Fatalf("SubmitAttempt: %v", err)
+	}
+	if result.Status != "captured" || result.XpGained != int32(testWord.Points) {
+		t.Fatalf("capture attendue, obtenu %v", result)
+	}
+
+	// La capture faite en gRPC est visible en REST, et inversement
+	var player api.PlayerJSON
+	getJSON(t, rest, "/v1/players/"+alice.Id, &player)
+	if player.XP != int(result.Player.Xp) || player.Inventory[testWord.ID] != 1 {
+		t.Errorf("REST et gRPC divergent: %+v / %v", player, result.Player)
+	}
+
+	var leaderboard []api.PlayerJSON
+	getJSON(t, rest, "/v1/leaderboard", &leaderboard)
+	board, err := client.GetLeaderboard(ctx, &wordmonpb.LeaderboardRequest{})
+	if err != nil {
+		t.Fatalf("GetLeaderboard: %v", err)
+	}
+	if len(board.Players) != len(leaderboard) || board.Players[0].Id != leaderboard[0].ID {
+		t.Errorf("classements différents: %v / %+v", board.Players, leaderboard)
+	}
+}
+
+func TestGRPCLeaderboardMatchesREST(t *testing.T) {
+	rest, client := newTestServers(t)
+	ctx := adminContext(t)
+	g := rest.Game()
+
+	players := []struct {
+		name, team string
+		xp         int
+	}{{"Alice", "rouge", 450}, {"Bob", "rouge", 120}, {"Carol", "bleu", 300}, {"Dave", "rouge", 450}, {"Eve", "rouge", 30}}
+	for _, p := range players {
+		player, err := g.CreatePlayerInTeam(ctx, p.name, p.team)
+		if err != nil {
+			t.Fatalf("joueur %s: %v", p.name, err)
+		}
+		g.SetPlayerXP(ctx, player.ID, p.xp)
+	}
+
+	// Même page, mêmes filtres et même curseur en REST et en gRPC
+	req := &wordmonpb.LeaderboardRequest{Limit: 2, Team: "rouge", MinLevel: 2}
+	var ids []string
+	for page := 0; ; page++ {
+		board, err := client.GetLeaderboard(ctx, req)
+		if err != nil {
+			t.Fatalf("GetLeaderboard: %v", err)
+		}
+		var entries []api.LeaderboardEntryJSON
+		getJSON(t, rest, "/v1/leaderboard?limit=2&team=rouge&minLevel=2&cursor="+req.Cursor, &entries)
+		if len(board.Players) != len(entries) || board.Total != 3 {
+			t.Fatalf("page %d: %v / %+v", page, board, entries)
+		}
+		for i, entry := range entries {
+			got := board.Players[i]
+			if got.Id != entry.ID || got.Team != entry.Team || int(got.Rank) != entry.Rank || int(got.Score) != entry.Score {
+				t.Errorf("page %d: %v / %+v", page, got, entry)
+			}
+			ids = append(ids, got.Id)
+		}
+		if board.NextCursor == "" {
+			break
+		}
+		req.Cursor = board.NextCursor
+	}
+	if len(ids) != 3 {
+		t.Errorf("3 joueurs rouges de niveau 2+ attendus, obtenu %v", ids)
+	}
+
+	var rank api.PlayerRankJSON
+	getJSON(t, rest, "/v1/players/"+ids[1]+"/rank?team=rouge", &rank)
+	got, err := client.GetPlayerRank(ctx, &wordmonpb.PlayerRankRequest{Id: ids[1], Team: "rouge"})
+	if err != nil {
+		t.Fatalf("GetPlayerRank: %v", err)
+	}
+	if int(got.Player.Rank) != rank.Rank || int(got.Total) != rank.Total || got.Percentile != rank.Percentile ||
+		got.By != rank.By || len(got.Above) != len(rank.Above) || len(got.Below) != len(rank.Below) {
+		t.Errorf("rangs différents: %v / %+v", got, rank)
+	}
+
+	if _, err := client.GetLeaderboard(ctx, &wordmonpb.LeaderboardRequest{By: "taille"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("critère inconnu: InvalidArgument attendu, obtenu %v", err)
+	}
+	if _, err := client.GetLeaderboard(ctx, &wordmonpb.LeaderboardRequest{Cursor: "%%"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("curseur invalide: InvalidArgument attendu, obtenu %v", err)
+	}
+	if _, err := client.GetPlayerRank(ctx, &wordmonpb.PlayerRankRequest{Id: "inconnu"}); status.Code(err) != codes.NotFound {
+		t.Errorf("joueur inconnu: NotFound attendu, obtenu %v", err)
+	}
+}
+
+func TestGRPCErrorCodes(t *testing.T) {
+	_, client := newTestServers(t)
+	ctx := adminContext(t)
+
+	_, err := client.GetPlayer(ctx, &wordmonpb.GetPlayerRequest{Id: "inconnu"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("joueur inconnu: NotFound attendu, obtenu %v", err)
+	}
+
+	alice, _ := client.CreatePlayer(ctx, &wordmonpb.CreatePlayerRequest{Name: "Alice"})
+	if _, err := client.CreatePlayer(ctx, &wordmonpb.CreatePlayerRequest{Name: "Alice"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("doublon: AlreadyExists attendu, obtenu %v", err)
+	}
+	if _, err := client.SubmitAttempt(ctx, &wordmonpb.SubmitAttemptRequest{PlayerId: alice.Id, SpawnId: "b999", Answer: "tach"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("spawn périmé: FailedPrecondition attendu, obtenu %v", err)
+	}
+	if _, err := client.SetPlayerXP(ctx, &wordmonpb.SetPlayerXPRequest{Id: alice.Id, Xp: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("XP négative: InvalidArgument attendu, obtenu %v", err)
+	}
+}
+
+func TestGRPCRequiresAdminToken(t *testing.T) {
+	_, client := newTestServers(t)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer mauvais")
+	if _, err := client.GetLeaderboard(ctx, &wordmonpb.LeaderboardRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("mauvais jeton: Unauthenticated attendu, obtenu %v", err)
+	}
+
+	stream, err := client.WatchSpawns(context.Background(), &wordmonpb.WatchSpawnsRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("flux sans jeton: Unauthenticated attendu, obtenu %v", err)
+	}
+}
+
+func TestWatchSpawnsStreamsCurrentThenCapture(t *testing.T) {
+	rest, client := newTestServers(t)
+	ctx := adminContext(t)
+
+	stream, err := client.WatchSpawns(ctx, &wordmonpb.WatchSpawnsRequest{})
+	if err != nil {
+		t.Fatalf("WatchSpawns: %v", err)
+	}
+	first, err := stream.Recv()
+	if err != nil || first.Kind != wordmonpb.SpawnEvent_KIND_SPAWNED || first.Spawn.Word.Text != testWord.Text {
+		t.Fatalf("spawn courant attendu en premier: %v %v", first, err)
+	}
+
+	// Capture via REST : le flux gRPC la rapporte
+	w := httptest.NewRecorder()
+	rest.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/players", strings.NewReader(`{"name":"Bob"}`)))
+	var bob api.PlayerJSON
+	json.Unmarshal(w.Body.Bytes(), &bob)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/encounter/attempt", strings.NewReader(`{"attempt":"tach"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+bob.Token)
+	w = httptest.NewRecorder()
+	rest.Handler().ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("tentative REST: %d %s", w.Code, w.Body.String())
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("flux interrompu: %v", err)
+		}
+		if event.Kind == wordmonpb.SpawnEvent_KIND_CAPTURED {
+			if event.PlayerId != bob.ID || event.Spawn.SpawnId != first.Spawn.SpawnId {
+				t.Errorf("capture inattendue: %v", event)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,1291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wordmon/v1/wordmon.proto
+
+// Interface gRPC des outils du maître du jeu. Elle s'appuie sur le même
+// service de jeu et le même store que l'API REST.
+
+package wordmonpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SpawnEvent_Kind int32
+
+const (
+	SpawnEvent_KIND_UNSPECIFIED SpawnEvent_Kind = 0
+	SpawnEvent_KIND_SPAWNED     SpawnEvent_Kind = 1
+	SpawnEvent_KIND_FLED        SpawnEvent_Kind = 2
+	SpawnEvent_KIND_CAPTURED    SpawnEvent_Kind = 3
+)
+
+// Enum value maps for SpawnEvent_Kind.
+var (
+	SpawnEvent_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_SPAWNED",
+		2: "KIND_FLED",
+		3: "KIND_CAPTURED",
+	}
+	SpawnEvent_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_SPAWNED":     1,
+		"KIND_FLED":        2,
+		"KIND_CAPTURED":    3,
+	}
+)
+
+func (x SpawnEvent_Kind) Enum() *SpawnEvent_Kind {
+	p := new(SpawnEvent_Kind)
+	*p = x
+	return p
+}
+
+func (x SpawnEvent_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpawnEvent_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordmon_v1_wordmon_proto_enumTypes[0].Descriptor()
+}
+
+func (SpawnEvent_Kind) Type() protoreflect.EnumType {
+	return &file_wordmon_v1_wordmon_proto_enumTypes[0]
+}
+
+func (x SpawnEvent_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpawnEvent_Kind.Descriptor instead.
+func (SpawnEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type Player struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Xp            int32                  `protobuf:"varint,3,opt,name=xp,proto3" json:"xp,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Inventory     map[string]int32       `protobuf:"bytes,5,rep,name=inventory,proto3" json:"inventory,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Team          string                 `protobuf:"bytes,6,opt,name=team,proto3" json:"team,omitempty"`
+	Rank          int32                  `protobuf:"varint,7,opt,name=rank,proto3" json:"rank,omitempty"`   // classements : rang dense (ex aequo au même rang)
+	Score         int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"` // classements : valeur du critère de classement
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *Player) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Player) GetInventory() map[string]int32 {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+func (x *Player) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *Player) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Player) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type Word struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Rarity        string                 `protobuf:"bytes,3,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Word) Reset() {
+	*x = Word{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Word) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Word) ProtoMessage() {}
+
+func (x *Word) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Word.ProtoReflect.Descriptor instead.
+func (*Word) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Word) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Word) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Word) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Word) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type Spawn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpawnId       string                 `protobuf:"bytes,1,opt,name=spawn_id,json=spawnId,proto3" json:"spawn_id,omitempty"`
+	Word          *Word                  `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spawn) Reset() {
+	*x = Spawn{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spawn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spawn) ProtoMessage() {}
+
+func (x *Spawn) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spawn.ProtoReflect.Descriptor instead.
+func (*Spawn) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Spawn) GetSpawnId() string {
+	if x != nil {
+		return x.SpawnId
+	}
+	return ""
+}
+
+func (x *Spawn) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+type CreatePlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlayerRequest) Reset() {
+	*x = CreatePlayerRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlayerRequest) ProtoMessage() {}
+
+func (x *CreatePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlayerRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePlayerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerRequest) Reset() {
+	*x = GetPlayerRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerRequest) ProtoMessage() {}
+
+func (x *GetPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPlayerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SetPlayerXPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Xp            int32                  `protobuf:"varint,2,opt,name=xp,proto3" json:"xp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerXPRequest) Reset() {
+	*x = SetPlayerXPRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerXPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerXPRequest) ProtoMessage() {}
+
+func (x *SetPlayerXPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerXPRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerXPRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetPlayerXPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetPlayerXPRequest) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+type DeletePlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlayerRequest) Reset() {
+	*x = DeletePlayerRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlayerRequest) ProtoMessage() {}
+
+func (x *DeletePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlayerRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeletePlayerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlayerResponse) Reset() {
+	*x = DeletePlayerResponse{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlayerResponse) ProtoMessage() {}
+
+func (x *DeletePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlayerResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{7}
+}
+
+type WatchSpawnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSpawnsRequest) Reset() {
+	*x = WatchSpawnsRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSpawnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSpawnsRequest) ProtoMessage() {}
+
+func (x *WatchSpawnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSpawnsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSpawnsRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{8}
+}
+
+type SpawnEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          SpawnEvent_Kind        `protobuf:"varint,1,opt,name=kind,proto3,enum=wordmon.v1.SpawnEvent_Kind" json:"kind,omitempty"`
+	Spawn         *Spawn                 `protobuf:"bytes,2,opt,name=spawn,proto3" json:"spawn,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                     // fuite : timeout, wrong_attempt...
+	PlayerId      string                 `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // capture : joueur gagnant
+	AtUnixMs      int64                  `protobuf:"varint,5,opt,name=at_unix_ms,json=atUnixMs,proto3" json:"at_unix_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpawnEvent) Reset() {
+	*x = SpawnEvent{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpawnEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnEvent) ProtoMessage() {}
+
+func (x *SpawnEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnEvent.ProtoReflect.Descriptor instead.
+func (*SpawnEvent) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SpawnEvent) GetKind() SpawnEvent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return SpawnEvent_KIND_UNSPECIFIED
+}
+
+func (x *SpawnEvent) GetSpawn() *Spawn {
+	if x != nil {
+		return x.Spawn
+	}
+	return nil
+}
+
+func (x *SpawnEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SpawnEvent) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SpawnEvent) GetAtUnixMs() int64 {
+	if x != nil {
+		return x.AtUnixMs
+	}
+	return 0
+}
+
+type SubmitAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	SpawnId       string                 `protobuf:"bytes,2,opt,name=spawn_id,json=spawnId,proto3" json:"spawn_id,omitempty"` // optionnel : le WordMon actuel si vide
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAttemptRequest) Reset() {
+	*x = SubmitAttemptRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAttemptRequest) ProtoMessage() {}
+
+func (x *SubmitAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAttemptRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubmitAttemptRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SubmitAttemptRequest) GetSpawnId() string {
+	if x != nil {
+		return x.SpawnId
+	}
+	return ""
+}
+
+func (x *SubmitAttemptRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type AttemptResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // captured, wrong, missed ou fled
+	SpawnId       string                 `protobuf:"bytes,2,opt,name=spawn_id,json=spawnId,proto3" json:"spawn_id,omitempty"`
+	Word          *Word                  `protobuf:"bytes,3,opt,name=word,proto3" json:"word,omitempty"`
+	Correct       bool                   `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XpGained      int32                  `protobuf:"varint,6,opt,name=xp_gained,json=xpGained,proto3" json:"xp_gained,omitempty"`
+	Player        *Player                `protobuf:"bytes,7,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttemptResult) Reset() {
+	*x = AttemptResult{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttemptResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttemptResult) ProtoMessage() {}
+
+func (x *AttemptResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttemptResult.ProtoReflect.Descriptor instead.
+func (*AttemptResult) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AttemptResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttemptResult) GetSpawnId() string {
+	if x != nil {
+		return x.SpawnId
+	}
+	return ""
+}
+
+func (x *AttemptResult) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *AttemptResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *AttemptResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AttemptResult) GetXpGained() int32 {
+	if x != nil {
+		return x.XpGained
+	}
+	return 0
+}
+
+func (x *AttemptResult) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	By            string                 `protobuf:"bytes,2,opt,name=by,proto3" json:"by,omitempty"`                              // xp (défaut), captures, legendary ou distinct
+	Team          string                 `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`                          // vide : toutes les équipes
+	MinLevel      int32                  `protobuf:"varint,4,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"` // 0 : pas de borne
+	MaxLevel      int32                  `protobuf:"varint,5,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"` // 0 : pas de borne
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // next_cursor de la page précédente
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetMinLevel() int32 {
+	if x != nil {
+		return x.MinLevel
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type LeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*Player              `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`                         // sans inventaire
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                            // joueurs retenus par les filtres
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // vide sur la dernière page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardResponse) Reset() {
+	*x = LeaderboardResponse{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardResponse) ProtoMessage() {}
+
+func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LeaderboardResponse) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *LeaderboardResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LeaderboardResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PlayerRankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	By            string                 `protobuf:"bytes,2,opt,name=by,proto3" json:"by,omitempty"`
+	Team          string                 `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
+	MinLevel      int32                  `protobuf:"varint,4,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	MaxLevel      int32                  `protobuf:"varint,5,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Neighbors     *int32                 `protobuf:"varint,6,opt,name=neighbors,proto3,oneof" json:"neighbors,omitempty"` // 1 si absent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRankRequest) Reset() {
+	*x = PlayerRankRequest{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRankRequest) ProtoMessage() {}
+
+func (x *PlayerRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRankRequest.ProtoReflect.Descriptor instead.
+func (*PlayerRankRequest) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlayerRankRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerRankRequest) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *PlayerRankRequest) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *PlayerRankRequest) GetMinLevel() int32 {
+	if x != nil {
+		return x.MinLevel
+	}
+	return 0
+}
+
+func (x *PlayerRankRequest) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *PlayerRankRequest) GetNeighbors() int32 {
+	if x != nil && x.Neighbors != nil {
+		return *x.Neighbors
+	}
+	return 0
+}
+
+type PlayerRank struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	By            string                 `protobuf:"bytes,2,opt,name=by,proto3" json:"by,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Percentile    float64                `protobuf:"fixed64,4,opt,name=percentile,proto3" json:"percentile,omitempty"` // joueurs au score inférieur ou égal, en %
+	Above         []*Player              `protobuf:"bytes,5,rep,name=above,proto3" json:"above,omitempty"`
+	Below         []*Player              `protobuf:"bytes,6,rep,name=below,proto3" json:"below,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRank) Reset() {
+	*x = PlayerRank{}
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRank) ProtoMessage() {}
+
+func (x *PlayerRank) ProtoReflect() protoreflect.Message {
+	mi := &file_wordmon_v1_wordmon_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRank.ProtoReflect.Descriptor instead.
+func (*PlayerRank) Descriptor() ([]byte, []int) {
+	return file_wordmon_v1_wordmon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlayerRank) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerRank) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *PlayerRank) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PlayerRank) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *PlayerRank) GetAbove() []*Player {
+	if x != nil {
+		return x.Above
+	}
+	return nil
+}
+
+func (x *PlayerRank) GetBelow() []*Player {
+	if x != nil {
+		return x.Below
+	}
+	return nil
+}
+
+var File_wordmon_v1_wordmon_proto protoreflect.FileDescriptor
+
+const file_wordmon_v1_wordmon_proto_rawDesc = "" +
+	"\n" +
+	"\x18wordmon/v1/wordmon.proto\x12\n" +
+	"wordmon.v1\"\x8f\x02\n" +
+	"\x06Player\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02xp\x18\x03 \x01(\x05R\x02xp\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12?\n" +
+	"\tinventory\x18\x05 \x03(\v2!.wordmon.v1.Player.InventoryEntryR\tinventory\x12\x12\n" +
+	"\x04team\x18\x06 \x01(\tR\x04team\x12\x12\n" +
+	"\x04rank\x18\a \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05score\x18\b \x01(\x05R\x05score\x1a<\n" +
+	"\x0eInventoryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"Z\n" +
+	"\x04Word\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x16\n" +
+	"\x06rarity\x18\x03 \x01(\tR\x06rarity\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\"H\n" +
+	"\x05Spawn\x12\x19\n" +
+	"\bspawn_id\x18\x01 \x01(\tR\aspawnId\x12$\n" +
+	"\x04word\x18\x02 \x01(\v2\x10.wordmon.v1.WordR\x04word\")\n" +
+	"\x13CreatePlayerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\"\n" +
+	"\x10GetPlayerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x12SetPlayerXPRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x0e\n" +
+	"\x02xp\x18\x02 \x01(\x05R\x02xp\"%\n" +
+	"\x13DeletePlayerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14DeletePlayerResponse\"\x14\n" +
+	"\x12WatchSpawnsRequest\"\x8b\x02\n" +
+	"\n" +
+	"SpawnEvent\x12/\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1b.wordmon.v1.SpawnEvent.KindR\x04kind\x12'\n" +
+	"\x05spawn\x18\x02 \x01(\v2\x11.wordmon.v1.SpawnR\x05spawn\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tplayer_id\x18\x04 \x01(\tR\bplayerId\x12\x1c\n" +
+	"\n" +
+	"at_unix_ms\x18\x05 \x01(\x03R\batUnixMs\"P\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fKIND_SPAWNED\x10\x01\x12\r\n" +
+	"\tKIND_FLED\x10\x02\x12\x11\n" +
+	"\rKIND_CAPTURED\x10\x03\"f\n" +
+	"\x14SubmitAttemptRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\bspawn_id\x18\x02 \x01(\tR\aspawnId\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\"\xe3\x01\n" +
+	"\rAttemptResult\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
+	"\bspawn_id\x18\x02 \x01(\tR\aspawnId\x12$\n" +
+	"\x04word\x18\x03 \x01(\v2\x10.wordmon.v1.WordR\x04word\x12\x18\n" +
+	"\acorrect\x18\x04 \x01(\bR\acorrect\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1b\n" +
+	"\txp_gained\x18\x06 \x01(\x05R\bxpGained\x12*\n" +
+	"\x06player\x18\a \x01(\v2\x12.wordmon.v1.PlayerR\x06player\"\xa0\x01\n" +
+	"\x12LeaderboardRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x0e\n" +
+	"\x02by\x18\x02 \x01(\tR\x02by\x12\x12\n" +
+	"\x04team\x18\x03 \x01(\tR\x04team\x12\x1b\n" +
+	"\tmin_level\x18\x04 \x01(\x05R\bminLevel\x12\x1b\n" +
+	"\tmax_level\x18\x05 \x01(\x05R\bmaxLevel\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"z\n" +
+	"\x13LeaderboardResponse\x12,\n" +
+	"\aplayers\x18\x01 \x03(\v2\x12.wordmon.v1.PlayerR\aplayers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xb2\x01\n" +
+	"\x11PlayerRankRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x0e\n" +
+	"\x02by\x18\x02 \x01(\tR\x02by\x12\x12\n" +
+	"\x04team\x18\x03 \x01(\tR\x04team\x12\x1b\n" +
+	"\tmin_level\x18\x04 \x01(\x05R\bminLevel\x12\x1b\n" +
+	"\tmax_level\x18\x05 \x01(\x05R\bmaxLevel\x12!\n" +
+	"\tneighbors\x18\x06 \x01(\x05H\x00R\tneighbors\x88\x01\x01B\f\n" +
+	"\n" +
+	"_neighbors\"\xd2\x01\n" +
+	"\n" +
+	"PlayerRank\x12*\n" +
+	"\x06player\x18\x01 \x01(\v2\x12.wordmon.v1.PlayerR\x06player\x12\x0e\n" +
+	"\x02by\x18\x02 \x01(\tR\x02by\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1e\n" +
+	"\n" +
+	"percentile\x18\x04 \x01(\x01R\n" +
+	"percentile\x12(\n" +
+	"\x05above\x18\x05 \x03(\v2\x12.wordmon.v1.PlayerR\x05above\x12(\n" +
+	"\x05below\x18\x06 \x03(\v2\x12.wordmon.v1.PlayerR\x05below2\xd8\x04\n" +
+	"\n" +
+	"GameMaster\x12C\n" +
+	"\fCreatePlayer\x12\x1f.wordmon.v1.CreatePlayerRequest\x1a\x12.wordmon.v1.Player\x12=\n" +
+	"\tGetPlayer\x12\x1c.wordmon.v1.GetPlayerRequest\x1a\x12.wordmon.v1.Player\x12A\n" +
+	"\vSetPlayerXP\x12\x1e.wordmon.v1.SetPlayerXPRequest\x1a\x12.wordmon.v1.Player\x12Q\n" +
+	"\fDeletePlayer\x12\x1f.wordmon.v1.DeletePlayerRequest\x1a .wordmon.v1.DeletePlayerResponse\x12G\n" +
+	"\vWatchSpawns\x12\x1e.wordmon.v1.WatchSpawnsRequest\x1a\x16.wordmon.v1.SpawnEvent0\x01\x12L\n" +
+	"\rSubmitAttempt\x12 .wordmon.v1.SubmitAttemptRequest\x1a\x19.wordmon.v1.AttemptResult\x12Q\n" +
+	"\x0eGetLeaderboard\x12\x1e.wordmon.v1.LeaderboardRequest\x1a\x1f.wordmon.v1.LeaderboardResponse\x12F\n" +
+	"\rGetPlayerRank\x12\x1d.wordmon.v1.PlayerRankRequest\x1a\x16.wordmon.v1.PlayerRankB;Z9github.com/SamG1008/wordmon-go/internal/grpcapi/wordmonpbb\x06proto3"
+
+var (
+	file_wordmon_v1_wordmon_proto_rawDescOnce sync.Once
+	file_wordmon_v1_wordmon_proto_rawDescData []byte
+)
+
+func file_wordmon_v1_wordmon_proto_rawDescGZIP() []byte {
+	file_wordmon_v1_wordmon_proto_rawDescOnce.Do(func() {
+		file_wordmon_v1_wordmon_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wordmon_v1_wordmon_proto_rawDesc), len(file_wordmon_v1_wordmon_proto_rawDesc)))
+	})
+	return file_wordmon_v1_wordmon_proto_rawDescData
+}
+
+var file_wordmon_v1_wordmon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wordmon_v1_wordmon_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_wordmon_v1_wordmon_proto_goTypes = []any{
+	(SpawnEvent_Kind)(0),         // 0: wordmon.v1.SpawnEvent.Kind
+	(*Player)(nil),               // 1: wordmon.v1.Player
+	(*Word)(nil),                 // 2: wordmon.v1.Word
+	(*Spawn)(nil),                // 3: wordmon.v1.Spawn
+	(*CreatePlayerRequest)(nil),  // 4: wordmon.v1.CreatePlayerRequest
+	(*GetPlayerRequest)(nil),     // 5: wordmon.v1.GetPlayerRequest
+	(*SetPlayerXPRequest)(nil),   // 6: wordmon.v1.SetPlayerXPRequest
+	(*DeletePlayerRequest)(nil),  // 7: wordmon.v1.DeletePlayerRequest
+	(*DeletePlayerResponse)(nil), // 8: wordmon.v1.DeletePlayerResponse
+	(*WatchSpawnsRequest)(nil),   // 9: wordmon.v1.WatchSpawnsRequest
+	(*SpawnEvent)(nil),           // 10: wordmon.v1.SpawnEvent
+	(*SubmitAttemptRequest)(nil), // 11: wordmon.v1.SubmitAttemptRequest
+	(*AttemptResult)(nil),        // 12: wordmon.v1.AttemptResult
+	(*LeaderboardRequest)(nil),   // 13: wordmon.v1.LeaderboardRequest
+	(*LeaderboardResponse)(nil),  // 14: wordmon.v1.LeaderboardResponse
+	(*PlayerRankRequest)(nil),    // 15: wordmon.v1.PlayerRankRequest
+	(*PlayerRank)(nil),           // 16: wordmon.v1.PlayerRank
+	nil,                          // 17: wordmon.v1.Player.InventoryEntry
+}
+var file_wordmon_v1_wordmon_proto_depIdxs = []int32{
+	17, // 0: wordmon.v1.Player.inventory:type_name -> wordmon.v1.Player.InventoryEntry
+	2,  // 1: wordmon.v1.Spawn.word:type_name -> wordmon.v1.Word
+	0,  // 2: wordmon.v1.SpawnEvent.kind:type_name -> wordmon.v1.SpawnEvent.Kind
+	3,  // 3: wordmon.v1.SpawnEvent.spawn:type_name -> wordmon.v1.Spawn
+	2,  // 4: wordmon.v1.AttemptResult.word:type_name -> wordmon.v1.Word
+	1,  // 5: wordmon.v1.AttemptResult.player:type_name -> wordmon.v1.Player
+	1,  // 6: wordmon.v1.LeaderboardResponse.players:type_name -> wordmon.v1.Player
+	1,  // 7: wordmon.v1.PlayerRank.player:type_name -> wordmon.v1.Player
+	1,  // 8: wordmon.v1.PlayerRank.above:type_name -> wordmon.v1.Player
+	1,  // 9: wordmon.v1.PlayerRank.below:type_name -> wordmon.v1.Player
+	4,  // 10: wordmon.v1.GameMaster.CreatePlayer:input_type -> wordmon.v1.CreatePlayerRequest
+	5,  // 11: wordmon.v1.GameMaster.GetPlayer:input_type -> wordmon.v1.GetPlayerRequest
+	6,  // 12: wordmon.v1.GameMaster.SetPlayerXP:input_type -> wordmon.v1.SetPlayerXPRequest
+	7,  // 13: wordmon.v1.GameMaster.DeletePlayer:input_type -> wordmon.v1.DeletePlayerRequest
+	9,  // 14: wordmon.v1.GameMaster.WatchSpawns:input_type -> wordmon.v1.WatchSpawnsRequest
+	11, // 15: wordmon.v1.GameMaster.SubmitAttempt:input_type -> wordmon.v1.SubmitAttemptRequest
+	13, // 16: wordmon.v1.GameMaster.GetLeaderboard:input_type -> wordmon.v1.LeaderboardRequest
+	15, // 17: wordmon.v1.GameMaster.GetPlayerRank:input_type -> wordmon.v1.PlayerRankRequest
+	1,  // 18: wordmon.v1.GameMaster.CreatePlayer:output_type -> wordmon.v1.Player
+	1,  // 19: wordmon.v1.GameMaster.GetPlayer:output_type -> wordmon.v1.Player
+	1,  // 20: wordmon.v1.GameMaster.SetPlayerXP:output_type -> wordmon.v1.Player
+	8,  // 21: wordmon.v1.GameMaster.DeletePlayer:output_type -> wordmon.v1.DeletePlayerResponse
+	10, // 22: wordmon.v1.GameMaster.WatchSpawns:output_type -> wordmon.v1.SpawnEvent
+	12, // 23: wordmon.v1.GameMaster.SubmitAttempt:output_type -> wordmon.v1.AttemptResult
+	14, // 24: wordmon.v1.GameMaster.GetLeaderboard:output_type -> wordmon.v1.LeaderboardResponse
+	16, // 25: wordmon.v1.GameMaster.GetPlayerRank:output_type -> wordmon.v1.PlayerRank
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_wordmon_v1_wordmon_proto_init() }
+func file_wordmon_v1_wordmon_proto_init() {
+	if File_wordmon_v1_wordmon_proto != nil {
+		return
+	}
+	file_wordmon_v1_wordmon_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wordmon_v1_wordmon_proto_rawDesc), len(file_wordmon_v1_wordmon_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wordmon_v1_wordmon_proto_goTypes,
+		DependencyIndexes: file_wordmon_v1_wordmon_proto_depIdxs,
+		EnumInfos:         file_wordmon_v1_wordmon_proto_enumTypes,
+		MessageInfos:      file_wordmon_v1_wordmon_proto_msgTypes,
+	}.Build()
+	File_wordmon_v1_wordmon_proto = out.File
+	file_wordmon_v1_wordmon_proto_goTypes = nil
+	file_wordmon_v1_wordmon_proto_depIdxs = nil
+}
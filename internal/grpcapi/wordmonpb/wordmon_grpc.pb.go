@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: wordmon/v1/wordmon.proto
+
+// Interface gRPC des outils du maître du jeu. Elle s'appuie sur le même
+// service de jeu et le même store que l'API REST.
+
+package wordmonpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GameMaster_CreatePlayer_FullMethodName   = "/wordmon.v1.GameMaster/CreatePlayer"
+	GameMaster_GetPlayer_FullMethodName      = "/wordmon.v1.GameMaster/GetPlayer"
+	GameMaster_SetPlayerXP_FullMethodName    = "/wordmon.v1.GameMaster/SetPlayerXP"
+	GameMaster_DeletePlayer_FullMethodName   = "/wordmon.v1.GameMaster/DeletePlayer"
+	GameMaster_WatchSpawns_FullMethodName    = "/wordmon.v1.GameMaster/WatchSpawns"
+	GameMaster_SubmitAttempt_FullMethodName  = "/wordmon.v1.GameMaster/SubmitAttempt"
+	GameMaster_GetLeaderboard_FullMethodName = "/wordmon.v1.GameMaster/GetLeaderboard"
+	GameMaster_GetPlayerRank_FullMethodName  = "/wordmon.v1.GameMaster/GetPlayerRank"
+)
+
+// GameMasterClient is the client API for GameMaster service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameMasterClient interface {
+	// Joueurs
+	CreatePlayer(ctx context.Context, in *CreatePlayerRequest, opts ...grpc.CallOption) (*Player, error)
+	GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*Player, error)
+	SetPlayerXP(ctx context.Context, in *SetPlayerXPRequest, opts ...grpc.CallOption) (*Player, error)
+	DeletePlayer(ctx context.Context, in *DeletePlayerRequest, opts ...grpc.CallOption) (*DeletePlayerResponse, error)
+	// Apparitions : le WordMon actuel puis chaque apparition, fuite ou capture
+	WatchSpawns(ctx context.Context, in *WatchSpawnsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SpawnEvent], error)
+	// Tentative de capture pour le compte d'un joueur
+	SubmitAttempt(ctx context.Context, in *SubmitAttemptRequest, opts ...grpc.CallOption) (*AttemptResult, error)
+	// Classement paginé et filtré (comme GET /leaderboard)
+	GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
+	// Rang, percentile et voisins d'un joueur (comme GET /players/{id}/rank)
+	GetPlayerRank(ctx context.Context, in *PlayerRankRequest, opts ...grpc.CallOption) (*PlayerRank, error)
+}
+
+type gameMasterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameMasterClient(cc grpc.ClientConnInterface) GameMasterClient {
+	return &gameMasterClient{cc}
+}
+
+func (c *gameMasterClient) CreatePlayer(ctx context.Context, in *CreatePlayerRequest, opts ...grpc.CallOption) (*Player, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Player)
+	err := c.cc.Invoke(ctx, GameMaster_CreatePlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterClient) GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*Player, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Player)
+	err := c.cc.Invoke(ctx, GameMaster_GetPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterClient) SetPlayerXP(ctx context.Context, in *SetPlayerXPRequest, opts ...grpc.CallOption) (*Player, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Player)
+	err := c.cc.Invoke(ctx, GameMaster_SetPlayerXP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterClient) DeletePlayer(ctx context.Context, in *DeletePlayerRequest, opts ...grpc.CallOption) (*DeletePlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlayerResponse)
+	err := c.cc.Invoke(ctx, GameMaster_DeletePlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterClient) WatchSpawns(ctx context.Context, in *WatchSpawnsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SpawnEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameMaster_ServiceDesc.Streams[0], GameMaster_WatchSpawns_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSpawnsRequest, SpawnEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameMaster_WatchSpawnsClient = grpc.ServerStreamingClient[SpawnEvent]
+
+func (c *gameMasterClient) SubmitAttempt(ctx context.Context, in *SubmitAttemptRequest, opts ...grpc.CallOption) (*AttemptResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttemptResult)
+	err := c.cc.Invoke(ctx, GameMaster_SubmitAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaderboardResponse)
+	err := c.cc.Invoke(ctx, GameMaster_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterClient) GetPlayerRank(ctx context.Context, in *PlayerRankRequest, opts ...grpc.CallOption) (*PlayerRank, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerRank)
+	err := c.cc.Invoke(ctx, GameMaster_GetPlayerRank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameMasterServer is the server API for GameMaster service.
+// All implementations must embed UnimplementedGameMasterServer
+// for forward compatibility.
+type GameMasterServer interface {
+	// Joueurs
+	CreatePlayer(context.Context, *CreatePlayerRequest) (*Player, error)
+	GetPlayer(context.Context, *GetPlayerRequest) (*Player, error)
+	SetPlayerXP(context.Context, *SetPlayerXPRequest) (*Player, error)
+	DeletePlayer(context.Context, *DeletePlayerRequest) (*DeletePlayerResponse, error)
+	// Apparitions : le WordMon actuel puis chaque apparition, fuite ou capture
+	WatchSpawns(*WatchSpawnsRequest, grpc.ServerStreamingServer[SpawnEvent]) error
+	// Tentative de capture pour le compte d'un joueur
+	SubmitAttempt(context.Context, *SubmitAttemptRequest) (*AttemptResult, error)
+	// Classement paginé et filtré (comme GET /leaderboard)
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
+	// Rang, percentile et voisins d'un joueur (comme GET /players/{id}/rank)
+	GetPlayerRank(context.Context, *PlayerRankRequest) (*PlayerRank, error)
+	mustEmbedUnimplementedGameMasterServer()
+}
+
+// UnimplementedGameMasterServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGameMasterServer struct{}
+
+func (UnimplementedGameMasterServer) CreatePlayer(context.Context, *CreatePlayerRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlayer not implemented")
+}
+func (UnimplementedGameMasterServer) GetPlayer(context.Context, *GetPlayerRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayer not implemented")
+}
+func (UnimplementedGameMasterServer) SetPlayerXP(context.Context, *SetPlayerXPRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlayerXP not implemented")
+}
+func (UnimplementedGameMasterServer) DeletePlayer(context.Context, *DeletePlayerRequest) (*DeletePlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlayer not implemented")
+}
+func (UnimplementedGameMasterServer) WatchSpawns(*WatchSpawnsRequest, grpc.ServerStreamingServer[SpawnEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSpawns not implemented")
+}
+func (UnimplementedGameMasterServer) SubmitAttempt(context.Context, *SubmitAttemptRequest) (*AttemptResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttempt not implemented")
+}
+func (UnimplementedGameMasterServer) GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedGameMasterServer) GetPlayerRank(context.Context, *PlayerRankRequest) (*PlayerRank, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerRank not implemented")
+}
+func (UnimplementedGameMasterServer) mustEmbedUnimplementedGameMasterServer() {}
+func (UnimplementedGameMasterServer) testEmbeddedByValue()                    {}
+
+// UnsafeGameMasterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameMasterServer will
+// result in compilation errors.
+type UnsafeGameMasterServer interface {
+	mustEmbedUnimplementedGameMasterServer()
+}
+
+func RegisterGameMasterServer(s grpc.ServiceRegistrar, srv GameMasterServer) {
+	// If the following call pancis, it indicates UnimplementedGameMasterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GameMaster_ServiceDesc, srv)
+}
+
+func _GameMaster_CreatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServer).CreatePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMaster_CreatePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServer).CreatePlayer(ctx, req.(*CreatePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMaster_GetPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServer).GetPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMaster_GetPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServer).GetPlayer(ctx, req.(*GetPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMaster_SetPlayerXP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerXPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServer).SetPlayerXP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMaster_SetPlayerXP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServer).SetPlayerXP(ctx, req.(*SetPlayerXPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMaster_DeletePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServer).DeletePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMaster_DeletePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServer).DeletePlayer(ctx, req.(*DeletePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMaster_WatchSpawns_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSpawnsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameMasterServer).WatchSpawns(m, &grpc.GenericServerStream[WatchSpawnsRequest, SpawnEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameMaster_WatchSpawnsServer = grpc.ServerStreamingServer[SpawnEvent]
+
+func _GameMaster_SubmitAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServer).SubmitAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMaster_SubmitAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServer).SubmitAttempt(ctx, req.(*SubmitAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMaster_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMaster_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServer).GetLeaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMaster_GetPlayerRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServer).GetPlayerRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMaster_GetPlayerRank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServer).GetPlayerRank(ctx, req.(*PlayerRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameMaster_ServiceDesc is the grpc.ServiceDesc for GameMaster service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameMaster_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordmon.v1.GameMaster",
+	HandlerType: (*GameMasterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlayer",
+			Handler:    _GameMaster_CreatePlayer_Handler,
+		},
+		{
+			MethodName: "GetPlayer",
+			Handler:    _GameMaster_GetPlayer_Handler,
+		},
+		{
+			MethodName: "SetPlayerXP",
+			Handler:    _GameMaster_SetPlayerXP_Handler,
+		},
+		{
+			MethodName: "DeletePlayer",
+			Handler:    _GameMaster_DeletePlayer_Handler,
+		},
+		{
+			MethodName: "SubmitAttempt",
+			Handler:    _GameMaster_SubmitAttempt_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _GameMaster_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetPlayerRank",
+			Handler:    _GameMaster_GetPlayerRank_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSpawns",
+			Handler:       _GameMaster_WatchSpawns_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "wordmon/v1/wordmon.proto",
+}
@@ -0,0 +1,222 @@
+package lexicon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+)
+
+// Minimum de mots par rareté exigé par configs/words.json
+const minPerRarity = 5
+
+// Scored mot classé : score de rareté dans [0, 1] et rareté retenue
+type Scored struct {
+	Entry
+	Score  float64
+	Rarity string // Common, Rare ou Legendary
+}
+
+// Classify calcule le score de rareté de chaque mot, moyenne pondérée de
+// signaux normalisés sur la liste, puis classe les meilleurs scores en
+// Legendary et Rare selon les parts configurées (au moins 5 par rareté).
+// Le résultat est trié par score décroissant.
+func Classify(entries []Entry, rules config.LexiconConfig) ([]Scored, error) {
+	if len(entries) < 3*minPerRarity {
+		return nil, fmt.Errorf("au moins %d mots requis pour classer les raretés, obtenu %d", 3*minPerRarity, len(entries))
+	}
+
+	lengths := make([]float64, len(entries))
+	for i, entry := range entries {
+		lengths[i] = float64(utf8.RuneCountInString(entry.Text))
+	}
+	lengths = normalize(lengths)
+	letters := letterRarity(entries)
+	anagrams := anagramScarcity(entries)
+	frequencies, hasFrequency := rarityFromFrequency(entries)
+
+	weights := rules.Weights
+	scored := make([]Scored, len(entries))
+	for i, entry := range entries {
+		total := weights.Length*lengths[i] + weights.LetterRarity*letters[i] + weights.Anagrams*anagrams[i]
+		weight := weights.Length + weights.LetterRarity + weights.Anagrams
+		if hasFrequency[i] {
+			total += weights.Frequency * frequencies[i]
+			weight += weights.Frequency
+		}
+		score := 0.0
+		if weight > 0 {
+			score = total / weight
+		}
+		scored[i] = Scored{Entry: entry, Score: score}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].Score != scored[j].Score {
+			return scored[i].Score > scored[j].Score
+		}
+		return scored[i].Text < scored[j].Text
+	})
+
+	legendary := share(len(scored), rules.LegendaryShare)
+	rare := share(len(scored), rules.RareShare)
+	if len(scored)-legendary-rare < minPerRarity {
+		return nil, fmt.Errorf("parts Rare et Legendary trop grandes pour %d mots", len(scored))
+	}
+	for i := range scored {
+		switch {
+		case i < legendary:
+			scored[i].Rarity = "Legendary"
+		case i < legendary+rare:
+			scored[i].Rarity = "Rare"
+		default:
+			scored[i].Rarity = "Common"
+		}
+	}
+	return scored, nil
+}
+
+// share nombre de mots d'une rareté : part arrondie, au moins 5
+func share(n int, part float64) int {
+	return max(minPerRarity, int(math.Round(float64(n)*part)))
+}
+
+// normalize ramène les valeurs dans [0, 1] (toutes à 0 si elles sont égales)
+func normalize(values []float64) []float64 {
+	if len(values) == 0 {
+		return values
+	}
+	lo, hi := values[0], values[0]
+	for _, v := range values {
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	normalized := make([]float64, len(values))
+	if hi == lo {
+		return normalized
+	}
+	for i, v := range values {
+		normalized[i] = (v - lo) / (hi - lo)
+	}
+	return normalized
+}
+
+// letterRarity rareté moyenne des lettres d'un mot (-log de leur fréquence
+// dans la liste) : un mot en z, k ou w sort du lot
+func letterRarity(entries []Entry) []float64 {
+	counts := map[rune]int{}
+	total := 0
+	for _, entry := range entries {
+		for _, letter := range entry.Text {
+			counts[letter]++
+			total++
+		}
+	}
+
+	values := make([]float64, len(entries))
+	for i, entry := range entries {
+		sum, n := 0.0, 0
+		for _, letter := range entry.Text {
+			sum += -math.Log(float64(counts[letter]) / float64(total))
+			n++
+		}
+		if n > 0 {
+			values[i] = sum / float64(n)
+		}
+	}
+	return normalize(values)
+}
+
+// anagramScarcity 1 pour un mot sans anagramme dans la liste, décroissant
+// avec leur nombre (un mot aux nombreuses anagrammes se devine mal)
+func anagramScarcity(entries []Entry) []float64 {
+	keys := make([]string, len(entries))
+	groups := map[string]int{}
+	for i, entry := range entries {
+		letters := []rune(entry.Text)
+		sort.Slice(letters, func(a, b int) bool { return letters[a] < letters[b] })
+		keys[i] = string(letters)
+		groups[keys[i]]++
+	}
+
+	values := make([]float64, len(entries))
+	for i, key := range keys {
+		values[i] = 1 / float64(groups[key])
+	}
+	return values
+}
+
+// rarityFromFrequency 1 pour le mot le moins fréquent de la liste, 0 pour le
+// plus fréquent (échelle logarithmique) ; seulement pour les mots ayant une
+// fréquence
+func rarityFromFrequency(entries []Entry) ([]float64, []bool) {
+	has := make([]bool, len(entries))
+	var logs []float64
+	var indexes []int
+	for i, entry := range entries {
+		if entry.HasFrequency {
+			has[i] = true
+			logs = append(logs, math.Log1p(entry.Frequency))
+			indexes = append(indexes, i)
+		}
+	}
+
+	values := make([]float64, len(entries))
+	for k, v := range normalize(logs) {
+		values[indexes[k]] = 1 - v
+	}
+	return values, has
+}
+
+// AssignIDs construit les entrées de words.json. Un mot déjà présent dans
+// existing garde son ID ; un nouveau mot reçoit un ID dérivé de son texte
+// ("w_" + empreinte), identique d'un import à l'autre quelle que soit sa
+// rareté. Les entrées sont triées par rareté puis par ID.
+func AssignIDs(words []Scored, existing []config.WordEntry) []config.WordEntry {
+	byText := make(map[string]string, len(existing))
+	used := make(map[string]bool, len(existing)+len(words))
+	for _, entry := range existing {
+		byText[entry.Text] = entry.ID
+	}
+
+	entries := make([]config.WordEntry, 0, len(words))
+	var fresh []int
+	for _, word := range words {
+		id, known := byText[word.Text]
+		if known {
+			used[id] = true
+		} else {
+			fresh = append(fresh, len(entries))
+		}
+		entries = append(entries, config.WordEntry{ID: id, Text: word.Text, Rarity: word.Rarity})
+	}
+	for _, i := range fresh {
+		entries[i].ID = stableID(entries[i].Text, used)
+		used[entries[i].ID] = true
+	}
+
+	order := map[string]int{"Common": 0, "Rare": 1, "Legendary": 2}
+	sort.Slice(entries, func(i, j int) bool {
+		if order[entries[i].Rarity] != order[entries[j].Rarity] {
+			return order[entries[i].Rarity] < order[entries[j].Rarity]
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	return entries
+}
+
+// stableID "w_" + les 8 premiers caractères hexadécimaux du SHA-256 du
+// texte, allongé en cas de collision
+func stableID(text string, used map[string]bool) string {
+	sum := sha256.Sum256([]byte(text))
+	digest := hex.EncodeToString(sum[:])
+	for n := 8; n < len(digest); n += 4 {
+		if id := "w_" + digest[:n]; !used[id] {
+			return id
+		}
+	}
+	return "w_" + digest
+}
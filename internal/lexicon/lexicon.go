@@ -0,0 +1,203 @@
+// Package lexicon importe des listes de mots (texte, CSV ou JSON) dans le
+// catalogue : filtrage selon les règles des mots du jeu, classement
+// automatique de la rareté et attribution d'IDs stables.
+package lexicon
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+)
+
+// Formats de liste reconnus
+const (
+	FormatText = "txt"
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// Entry mot lu dans une liste, avec sa fréquence d'usage éventuelle
+type Entry struct {
+	Text         string
+	Frequency    float64
+	HasFrequency bool
+}
+
+// FormatFromPath déduit le format de l'extension (txt par défaut)
+func FormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV
+	case ".json":
+		return FormatJSON
+	default:
+		return FormatText
+	}
+}
+
+// Read lit une liste de mots :
+//   - txt : un mot par ligne, lignes vides et commentaires # ignorés ;
+//   - csv : colonnes mot[,fréquence], en-tête facultatif ;
+//   - json : tableau de chaînes ou d'objets {"text" (ou "word"), "frequency"}.
+func Read(r io.Reader, format string) ([]Entry, error) {
+	switch format {
+	case FormatText:
+		return readText(r)
+	case FormatCSV:
+		return readCSV(r)
+	case FormatJSON:
+		return readJSON(r)
+	default:
+		return nil, fmt.Errorf("format de liste inconnu: %s (txt, csv ou json)", format)
+	}
+}
+
+func readText(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, Entry{Text: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("erreur lecture liste: %w", err)
+	}
+	return entries, nil
+}
+
+func readCSV(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var entries []Entry
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("erreur lecture CSV: %w", err)
+		}
+		if len(record) == 0 || strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		entry := Entry{Text: strings.TrimSpace(record[0])}
+		if len(record) > 1 && strings.TrimSpace(record[1]) != "" {
+			frequency, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+			if err != nil {
+				if line == 1 {
+					continue // en-tête (word,frequency)
+				}
+				return nil, fmt.Errorf("fréquence invalide ligne %d: %s", line, record[1])
+			}
+			if frequency < 0 {
+				return nil, fmt.Errorf("fréquence négative ligne %d: %s", line, record[1])
+			}
+			entry.Frequency, entry.HasFrequency = frequency, true
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func readJSON(r io.Reader) ([]Entry, error) {
+	var raw []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("erreur parsing JSON liste: %w", err)
+	}
+
+	entries := make([]Entry, 0, len(raw))
+	for i, item := range raw {
+		var text string
+		if err := json.Unmarshal(item, &text); err == nil {
+			entries = append(entries, Entry{Text: text})
+			continue
+		}
+		var object struct {
+			Text      string   `json:"text"`
+			Word      string   `json:"word"`
+			Frequency *float64 `json:"frequency"`
+		}
+		if err := json.Unmarshal(item, &object); err != nil {
+			return nil, fmt.Errorf("entrée %d: chaîne ou objet {text, frequency} attendu", i)
+		}
+		entry := Entry{Text: object.Text}
+		if entry.Text == "" {
+			entry.Text = object.Word
+		}
+		if object.Frequency != nil {
+			if *object.Frequency < 0 {
+				return nil, fmt.Errorf("entrée %d: fréquence négative", i)
+			}
+			entry.Frequency, entry.HasFrequency = *object.Frequency, true
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Rejection mot écarté par Filter
+type Rejection struct {
+	Text   string
+	Reason string
+}
+
+// Filter normalise les mots (minuscules, espaces de bord retirés) et écarte
+// ceux qui ne respectent pas les règles du catalogue : pas d'espace, lettres
+// de l'alphabet configuré, longueur bornée, pas de doublon
+func Filter(entries []Entry, rules config.LexiconConfig) ([]Entry, []Rejection) {
+	alphabet := make(map[rune]bool)
+	for _, letter := range rules.Alphabet {
+		alphabet[letter] = true
+	}
+
+	var kept []Entry
+	var rejected []Rejection
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		text := strings.ToLower(strings.TrimSpace(entry.Text))
+		reason := ""
+		switch length := utf8.RuneCountInString(text); {
+		case text == "":
+			reason = "vide"
+		case strings.ContainsAny(text, " \t"):
+			reason = "contient des espaces"
+		case length < rules.MinLength:
+			reason = fmt.Sprintf("moins de %d lettres", rules.MinLength)
+		case length > rules.MaxLength:
+			reason = fmt.Sprintf("plus de %d lettres", rules.MaxLength)
+		case seen[text]:
+			reason = "doublon"
+		default:
+			for _, letter := range text {
+				if !alphabet[letter] {
+					reason = fmt.Sprintf("caractère hors alphabet: %q", letter)
+					break
+				}
+			}
+		}
+		if reason != "" {
+			rejected = append(rejected, Rejection{Text: entry.Text, Reason: reason})
+			continue
+		}
+
+		seen[text] = true
+		entry.Text = text
+		kept = append(kept, entry)
+	}
+	return kept, rejected
+}
@@ -0,0 +1,102 @@
+package lexicon
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/config"
+)
+
+var testRules = config.LexiconConfig{
+	Alphabet:       "abcdefghijklmnopqrstuvwxyzéèà",
+	MinLength:      3,
+	MaxLength:      12,
+	Weights:        config.LexiconWeights{Length: 1, LetterRarity: 1, Anagrams: 0.5, Frequency: 2},
+	RareShare:      0.3,
+	LegendaryShare: 0.1,
+}
+
+func TestReadFormats(t *testing.T) {
+	cases := map[string]string{
+		FormatText: "# commentaire\nchat\n\n  lune  \n",
+		FormatCSV:  "word,frequency\nchat,120.5\nlune,\n",
+		FormatJSON: `["chat", {"word": "lune", "frequency": 3}]`,
+	}
+	for format, input := range cases {
+		entries, err := Read(strings.NewReader(input), format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if len(entries) != 2 || entries[0].Text != "chat" || entries[1].Text != "lune" {
+			t.Errorf("%s: chat et lune attendus, obtenu %+v", format, entries)
+		}
+	}
+
+	entries, _ := Read(strings.NewReader("chat,120.5\n"), FormatCSV)
+	if !entries[0].HasFrequency || entries[0].Frequency != 120.5 {
+		t.Errorf("fréquence CSV perdue: %+v", entries)
+	}
+	if _, err := Read(strings.NewReader("chat,1\nlune,beaucoup\n"), FormatCSV); err == nil {
+		t.Error("fréquence illisible hors en-tête: erreur attendue")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	entries := []Entry{{Text: "Chat"}, {Text: "chat"}, {Text: "pomme de terre"}, {Text: "ok"}, {Text: "naïf"}, {Text: "café"}}
+	kept, rejected := Filter(entries, testRules)
+	if len(kept) != 2 || kept[0].Text != "chat" || kept[1].Text != "café" {
+		t.Errorf("chat et café attendus, obtenu %+v", kept)
+	}
+	if len(rejected) != 4 {
+		t.Errorf("4 rejets attendus (doublon, espaces, trop court, ï), obtenu %+v", rejected)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	var entries []Entry
+	for i := range 40 {
+		// Fréquence décroissante : les derniers mots sont les plus rares
+		entries = append(entries, Entry{Text: fmt.Sprintf("mot%c%c", 'a'+i/26, 'a'+i%26), Frequency: float64(1000 - i*20), HasFrequency: true})
+	}
+
+	scored, err := Classify(entries, config.LexiconConfig{
+		Weights: config.LexiconWeights{Frequency: 1}, RareShare: 0.25, LegendaryShare: 0.1,
+	})
+	if err != nil {
+		t.Fatalf("classement: %v", err)
+	}
+	counts := map[string]int{}
+	for _, word := range scored {
+		counts[word.Rarity]++
+	}
+	if counts["Legendary"] != 5 || counts["Rare"] != 10 || counts["Common"] != 25 {
+		t.Errorf("répartition 5/10/25 attendue (minimum 5), obtenu %v", counts)
+	}
+	if scored[0].Text != entries[39].Text || scored[0].Rarity != "Legendary" {
+		t.Errorf("le mot le moins fréquent doit être Legendary: %+v", scored[0])
+	}
+
+	if _, err := Classify(entries[:10], testRules); err == nil {
+		t.Error("10 mots: erreur attendue (5 par rareté)")
+	}
+}
+
+func TestAssignIDsIsStable(t *testing.T) {
+	words := []Scored{
+		{Entry: Entry{Text: "chat"}, Rarity: "Common"},
+		{Entry: Entry{Text: "dragon"}, Rarity: "Legendary"},
+	}
+	existing := []config.WordEntry{{ID: "c_1", Text: "chat", Rarity: "Common"}}
+
+	first := AssignIDs(words, existing)
+	if first[0].ID != "c_1" || !strings.HasPrefix(first[1].ID, "w_") {
+		t.Errorf("c_1 conservé et ID w_ attendus: %+v", first)
+	}
+
+	// Même ID pour le même texte, quelle que soit sa rareté
+	words[1].Rarity = "Rare"
+	if again := AssignIDs(words, nil); again[1].ID != first[1].ID {
+		t.Errorf("ID instable: %s puis %s", first[1].ID, again[1].ID)
+	}
+}
@@ -0,0 +1,106 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+)
+
+// Adoption reprise d'une migration sur une base créée avant schema_migrations
+// (AutoMigrate GORM ou scripts SQL appliqués à la main). Check renvoie vrai
+// si le schéma contient déjà ce que crée la migration ; Fix, idempotent,
+// complète les écarts tolérés (index absents).
+type Adoption struct {
+	Check string
+	Fix   string
+}
+
+// columnExists condition SQL : la colonne existe dans le schéma courant
+func columnExists(table, column string) string {
+	return fmt.Sprintf(`EXISTS (SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = '%s' AND column_name = '%s')`, table, column)
+}
+
+// defaultPrevious empreintes antérieures des migrations embarquées réécrites
+// depuis leur publication, par version
+var defaultPrevious = map[int][]string{
+	// 0006 supprimait les captures orphelines ; elle échoue désormais en les listant
+	6: {"e68a70e0abf1303e8c4dcb7ffac4de3a371c4633c41c5e6ce912be824eed7321"},
+}
+
+// defaultAdoptions reprises des migrations embarquées, par version
+var defaultAdoptions = map[int]Adoption{
+	1: {Check: `SELECT to_regclass('players') IS NOT NULL AND to_regclass('words') IS NOT NULL
+		AND to_regclass('captures') IS NOT NULL`},
+	2: {
+		Check: `SELECT to_regclass('webhooks') IS NOT NULL AND to_regclass('webhook_deliveries') IS NOT NULL`,
+		Fix:   `CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id, created_at DESC)`,
+	},
+	3: {
+		Check: `SELECT to_regclass('player_tokens') IS NOT NULL`,
+		Fix:   `CREATE INDEX IF NOT EXISTS player_tokens_player_idx ON player_tokens (player_id)`,
+	},
+	4: {Check: `SELECT to_regclass('rate_limit_buckets') IS NOT NULL AND to_regclass('rate_limit_counters') IS NOT NULL
+		AND to_regclass('rate_limit_blocks') IS NOT NULL`},
+	5: {
+		Check: `SELECT ` + columnExists("players", "team"),
+		Fix: `CREATE INDEX IF NOT EXISTS players_team_idx ON players (team);
+			CREATE INDEX IF NOT EXISTS players_xp_idx ON players (xp DESC, id);`,
+	},
+	6: {Check: `SELECT NOT EXISTS (SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'captures'
+		AND column_name IN ('player_id', 'word_id') AND is_nullable = 'YES')`},
+	7: {Check: `SELECT ` + columnExists("words", "retired_at") + ` AND to_regclass('word_catalog_versions') IS NOT NULL`},
+}
+
+// adoptable retient, dans l'ordre, les migrations que le schéma contient
+// déjà ; la première absente (ou sans reprise) arrête la recherche, les
+// suivantes seront appliquées normalement
+func adoptable(list []Migration, check func(query string) (bool, error)) ([]Migration, error) {
+	var adopted []Migration
+	for _, migration := range list {
+		if migration.Adopt == nil {
+			break
+		}
+		present, err := check(migration.Adopt.Check)
+		if err != nil {
+			return nil, fmt.Errorf("erreur reprise %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		if !present {
+			break
+		}
+		adopted = append(adopted, migration)
+	}
+	return adopted, nil
+}
+
+// adopt enregistre comme appliquées les migrations déjà présentes dans une
+// base sans historique qui contient la table players ; retourne leur nombre
+func adopt(ctx context.Context, conn *sql.Conn, list []Migration) (int, error) {
+	var legacy bool
+	if err := conn.QueryRowContext(ctx, `SELECT to_regclass('players') IS NOT NULL`).Scan(&legacy); err != nil {
+		return 0, fmt.Errorf("erreur détection du schéma existant: %w", err)
+	}
+	if !legacy {
+		return 0, nil
+	}
+
+	adopted, err := adoptable(list, func(query string) (bool, error) {
+		var present bool
+		err := conn.QueryRowContext(ctx, query).Scan(&present)
+		return present, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, migration := range adopted {
+		if err := run(ctx, conn, migration.Adopt.Fix,
+			`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+			migration.Version, migration.Name, migration.Checksum); err != nil {
+			return 0, fmt.Errorf("erreur reprise %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		log.Printf("[migrate] %04d_%s reprise du schéma existant", migration.Version, migration.Name)
+	}
+	return len(adopted), nil
+}
@@ -0,0 +1,101 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+)
+
+func TestAdoptable(t *testing.T) {
+	list := []Migration{
+		{Version: 1, Name: "a", Adopt: &Adoption{Check: "1"}},
+		{Version: 2, Name: "b", Adopt: &Adoption{Check: "2"}},
+		{Version: 3, Name: "c", Adopt: &Adoption{Check: "3"}},
+		{Version: 4, Name: "d"},
+	}
+
+	// Schéma AutoMigrate : 1 et 3 présents, 2 absent
+	present := map[string]bool{"1": true, "3": true}
+	adopted, err := adoptable(list, func(query string) (bool, error) { return present[query], nil })
+	if err != nil || len(adopted) != 1 || adopted[0].Version != 1 {
+		t.Errorf("0001 seule attendue (0002 absente), obtenu %v (%v)", adopted, err)
+	}
+
+	// Tout présent : la migration sans reprise arrête la recherche
+	adopted, _ = adoptable(list, func(string) (bool, error) { return true, nil })
+	if len(adopted) != 3 {
+		t.Errorf("0001 à 0003 attendues, obtenu %v", adopted)
+	}
+}
+
+// TestUpAdoptsLegacySchema part d'une base créée par l'ancien bootstrap SQL
+// (0001 à 0005 sans schema_migrations) ; WORDMON_TEST_DATABASE_URL doit
+// désigner une base Postgres jetable
+func TestUpAdoptsLegacySchema(t *testing.T) {
+	url := os.Getenv("WORDMON_TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("WORDMON_TEST_DATABASE_URL non définie")
+	}
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatalf("connexion: %v", err)
+	}
+	defer db.Close()
+
+	// Schéma dédié sur une connexion unique : search_path reste en place
+	ctx := context.Background()
+	db.SetMaxOpenConns(1)
+	schema := fmt.Sprintf("wordmon_adopt_%d", os.Getpid())
+	for _, query := range []string{
+		`CREATE SCHEMA ` + schema,
+		`SET search_path TO ` + schema,
+	} {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+	}
+	defer db.ExecContext(ctx, `DROP SCHEMA `+schema+` CASCADE`)
+
+	migrator, err := Default(db)
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+	for _, migration := range migrator.migrations[:5] {
+		if _, err := db.ExecContext(ctx, migration.Up); err != nil {
+			t.Fatalf("ancien schéma %04d: %v", migration.Version, err)
+		}
+	}
+	if _, err := db.ExecContext(ctx,
+		`INSERT INTO players (id, name) VALUES ('6f1c1a9e-4b8e-4f0a-9c55-2f5c4c1e0b11', 'sacha')`); err != nil {
+		t.Fatalf("joueur existant: %v", err)
+	}
+
+	// 0001 à 0005 reprises, 0006 (captures nullables) et 0007 appliquées
+	count, err := migrator.Up(ctx)
+	if err != nil || count != 2 {
+		t.Fatalf("0006 et 0007 attendues, obtenu %d (%v)", count, err)
+	}
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	for _, s := range statuses {
+		if !s.Applied || s.Modified {
+			t.Errorf("%04d_%s non appliquée", s.Version, s.Name)
+		}
+	}
+	var players int
+	db.QueryRowContext(ctx, `SELECT COUNT(*) FROM players`).Scan(&players)
+	if players != 1 {
+		t.Errorf("joueur existant perdu: %d joueur(s)", players)
+	}
+
+	// Déjà à jour : plus rien à reprendre ni appliquer
+	if count, err := migrator.Up(ctx); err != nil || count != 0 {
+		t.Errorf("aucune migration attendue, obtenu %d (%v)", count, err)
+	}
+}
@@ -0,0 +1,364 @@
+// Package migrate applique les migrations SQL versionnées du schéma Postgres.
+// Les versions appliquées sont enregistrées dans schema_migrations avec
+// l'empreinte de leur fichier .up.sql : une migration modifiée après coup
+// bloque toute nouvelle opération.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/db/migrations"
+)
+
+// Clé du verrou consultatif Postgres : une seule instance migre à la fois
+const lockKey = 7_316_204_561
+
+// Erreurs du moteur de migration
+var (
+	ErrChecksumMismatch = errors.New("migration modifiée après application")
+	ErrUnknownVersion   = errors.New("version appliquée sans fichier de migration")
+)
+
+// Migration une version du schéma
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string    // SHA-256 du fichier .up.sql
+	Previous []string  // empreintes antérieures acceptées (fichier réécrit sans effet sur le schéma)
+	Adopt    *Adoption // reprise d'une base antérieure à schema_migrations (nil : aucune)
+}
+
+// matches indique si checksum, enregistré à l'application, correspond au fichier
+func (m Migration) matches(checksum string) bool {
+	return checksum == m.Checksum || slices.Contains(m.Previous, checksum)
+}
+
+// Status état d'une migration dans la base
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+	Modified  bool // empreinte différente de celle enregistrée
+}
+
+// applied ligne de schema_migrations
+type applied struct {
+	checksum string
+	at       time.Time
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Load lit les migrations à la racine de fsys, triées par version ; chaque
+// version doit avoir ses deux fichiers
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture migrations: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		if version <= 0 {
+			return nil, fmt.Errorf("version de migration invalide: %s", entry.Name())
+		}
+
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("erreur lecture %s: %w", entry.Name(), err)
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("version %d en double: %s et %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(data)
+			sum := sha256.Sum256(data)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(data)
+		}
+	}
+
+	list := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s incomplète: fichiers .up.sql et .down.sql requis", migration.Version, migration.Name)
+		}
+		list = append(list, *migration)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
+
+// Migrator applique une liste de migrations sur une base
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New crée un moteur de migration pour migrations
+func New(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{db: db, migrations: migrations}
+}
+
+// Default crée un moteur avec les migrations embarquées du projet
+func Default(db *sql.DB) (*Migrator, error) {
+	list, err := Load(migrations.Files)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		if adoption, exists := defaultAdoptions[list[i].Version]; exists {
+			list[i].Adopt = &adoption
+		}
+		list[i].Previous = defaultPrevious[list[i].Version]
+	}
+	return New(db, list), nil
+}
+
+// Latest retourne la dernière version connue (0 sans migration)
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status retourne l'état de chaque migration, plus celles appliquées
+// mais absentes des fichiers (Name vide)
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erreur connexion: %w", err)
+	}
+	defer conn.Close()
+
+	if err := ensureTable(ctx, conn); err != nil {
+		return nil, err
+	}
+	done, err := readApplied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	return status(m.migrations, done), nil
+}
+
+// Up applique toutes les migrations en attente
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	return m.To(ctx, m.Latest())
+}
+
+// Down annule les steps dernières migrations appliquées
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		return 0, fmt.Errorf("nombre de migrations à annuler invalide: %d", steps)
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var versions []int
+	for _, s := range statuses {
+		if s.Applied {
+			versions = append(versions, s.Version)
+		}
+	}
+	target := 0
+	if steps < len(versions) {
+		target = versions[len(versions)-1-steps]
+	}
+	return m.To(ctx, target)
+}
+
+// To amène le schéma à la version target : applique les migrations en
+// attente jusqu'à target, annule celles au-delà. Chaque migration s'exécute
+// dans sa propre transaction avec sa ligne de schema_migrations.
+func (m *Migrator) To(ctx context.Context, target int) (int, error) {
+	if target < 0 || target > m.Latest() {
+		return 0, fmt.Errorf("version cible inconnue: %d (dernière: %d)", target, m.Latest())
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("erreur connexion: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return 0, fmt.Errorf("erreur verrou migrations: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockKey)
+
+	if err := ensureTable(ctx, conn); err != nil {
+		return 0, err
+	}
+	done, err := readApplied(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+
+	// Base sans historique mais déjà peuplée : reprendre son schéma
+	if len(done) == 0 {
+		adopted, err := adopt(ctx, conn, m.migrations)
+		if err != nil {
+			return 0, err
+		}
+		if adopted > 0 {
+			if done, err = readApplied(ctx, conn); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	ups, downs, err := plan(m.migrations, done, target)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, migration := range downs {
+		if err := run(ctx, conn, migration.Down,
+			`DELETE FROM schema_migrations WHERE version = $1`, migration.Version); err != nil {
+			return count, fmt.Errorf("erreur annulation %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		log.Printf("[migrate] %04d_%s annulée", migration.Version, migration.Name)
+		count++
+	}
+	for _, migration := range ups {
+		if err := run(ctx, conn, migration.Up,
+			`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+			migration.Version, migration.Name, migration.Checksum); err != nil {
+			return count, fmt.Errorf("erreur application %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		log.Printf("[migrate] %04d_%s appliquée", migration.Version, migration.Name)
+		count++
+	}
+	return count, nil
+}
+
+// plan calcule les migrations à appliquer (ordre croissant) et à annuler
+// (ordre décroissant) pour atteindre target, après vérification des empreintes
+func plan(list []Migration, done map[int]applied, target int) (ups, downs []Migration, err error) {
+	known := map[int]bool{}
+	for _, migration := range list {
+		known[migration.Version] = true
+		row, isApplied := done[migration.Version]
+		if isApplied && !migration.matches(row.checksum) {
+			return nil, nil, fmt.Errorf("%w: %04d_%s", ErrChecksumMismatch, migration.Version, migration.Name)
+		}
+		if !isApplied && migration.Version <= target {
+			ups = append(ups, migration)
+		}
+		if isApplied && migration.Version > target {
+			downs = append([]Migration{migration}, downs...)
+		}
+	}
+	for version := range done {
+		if !known[version] {
+			return nil, nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+		}
+	}
+	return ups, downs, nil
+}
+
+// status fusionne les fichiers et les versions appliquées
+func status(list []Migration, done map[int]applied) []Status {
+	var statuses []Status
+	known := map[int]bool{}
+	for _, migration := range list {
+		known[migration.Version] = true
+		s := Status{Migration: migration}
+		if row, isApplied := done[migration.Version]; isApplied {
+			s.Applied, s.AppliedAt = true, row.at
+			s.Modified = !migration.matches(row.checksum)
+		}
+		statuses = append(statuses, s)
+	}
+	for version, row := range done {
+		if !known[version] {
+			statuses = append(statuses, Status{Migration: Migration{Version: version}, Applied: true, AppliedAt: row.at})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses
+}
+
+// ensureTable crée schema_migrations si besoin
+func ensureTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INT PRIMARY KEY,
+			name TEXT NOT NULL,
+			checksum TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`)
+	if err != nil {
+		return fmt.Errorf("erreur création schema_migrations: %w", err)
+	}
+	return nil
+}
+
+// readApplied lit les versions appliquées
+func readApplied(ctx context.Context, conn *sql.Conn) (map[int]applied, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	done := map[int]applied{}
+	for rows.Next() {
+		var version int
+		var row applied
+		if err := rows.Scan(&version, &row.checksum, &row.at); err != nil {
+			return nil, fmt.Errorf("erreur lecture schema_migrations: %w", err)
+		}
+		done[version] = row
+	}
+	return done, rows.Err()
+}
+
+// run exécute script puis la mise à jour de schema_migrations dans une transaction
+func run(ctx context.Context, conn *sql.Conn, script string, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if script != "" {
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
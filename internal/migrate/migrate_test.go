@@ -0,0 +1,74 @@
+package migrate
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/SamG1008/wordmon-go/db/migrations"
+)
+
+func TestLoadEmbeddedMigrations(t *testing.T) {
+	list, err := Load(migrations.Files)
+	if err != nil {
+		t.Fatalf("chargement: %v", err)
+	}
+	for i, migration := range list {
+		if migration.Version != i+1 {
+			t.Errorf("versions continues attendues: position %d, version %d", i, migration.Version)
+		}
+	}
+	if list[0].Name != "init" || list[0].Checksum == "" {
+		t.Errorf("0001_init inattendue: %+v", list[0])
+	}
+}
+
+func TestLoadRejectsIncompleteMigration(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_init.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+		"0001_init.down.sql": {Data: []byte("DROP TABLE a;")},
+		"0002_b.up.sql":      {Data: []byte("CREATE TABLE b (id INT);")},
+		"README.md":          {Data: []byte("ignoré")},
+	}
+	if _, err := Load(fsys); err == nil {
+		t.Error("0002 sans .down.sql: erreur attendue")
+	}
+}
+
+func TestPlan(t *testing.T) {
+	list := []Migration{
+		{Version: 1, Name: "a", Checksum: "1"},
+		{Version: 2, Name: "b", Checksum: "2"},
+		{Version: 3, Name: "c", Checksum: "3"},
+	}
+	done := map[int]applied{1: {checksum: "1"}, 2: {checksum: "2"}}
+
+	ups, downs, err := plan(list, done, 3)
+	if err != nil || len(ups) != 1 || ups[0].Version != 3 || len(downs) != 0 {
+		t.Errorf("up: 0003 seule attendue, obtenu %v / %v (%v)", ups, downs, err)
+	}
+
+	ups, downs, _ = plan(list, done, 0)
+	if len(ups) != 0 || len(downs) != 2 || downs[0].Version != 2 || downs[1].Version != 1 {
+		t.Errorf("to 0: 0002 puis 0001 attendues, obtenu %v / %v", ups, downs)
+	}
+
+	done[2] = applied{checksum: "modifiée"}
+	if _, _, err := plan(list, done, 3); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("empreinte différente: ErrChecksumMismatch attendu, obtenu %v", err)
+	}
+
+	list[1].Previous = []string{"modifiée"}
+	if _, _, err := plan(list, done, 3); err != nil {
+		t.Errorf("empreinte antérieure acceptée attendue, obtenu %v", err)
+	}
+	if statuses := status(list, done); statuses[1].Modified {
+		t.Error("empreinte antérieure: 0002 ne doit pas être marquée modifiée")
+	}
+	list[1].Previous = nil
+
+	done = map[int]applied{1: {checksum: "1"}, 9: {checksum: "9"}}
+	if _, _, err := plan(list, done, 3); !errors.Is(err, ErrUnknownVersion) {
+		t.Errorf("version inconnue: ErrUnknownVersion attendu, obtenu %v", err)
+	}
+}
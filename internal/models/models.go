@@ -13,6 +13,7 @@ type Player struct {
 	Name     string    `gorm:"type:text;not null;unique" json:"name"`
 	XP       int       `gorm:"not null;default:0" json:"xp"`
 	Level    int       `gorm:"not null;default:1" json:"level"`
+	Team     string    `gorm:"type:text;not null;default:'';index:players_team_idx" json:"team"`
 	Captures []Capture `gorm:"foreignKey:PlayerID" json:"captures,omitempty"`
 }
 
@@ -26,11 +27,23 @@ func (p *Player) BeforeCreate(tx *gorm.DB) error {
 
 // Word modèle GORM pour la table words
 type Word struct {
-	ID       string    `gorm:"type:text;primaryKey" json:"id"`
-	Text     string    `gorm:"type:text;not null" json:"text"`
-	Rarity   string    `gorm:"type:text;not null" json:"rarity"`
-	Points   int       `gorm:"not null" json:"points"`
-	Captures []Capture `gorm:"foreignKey:WordID" json:"captures,omitempty"`
+	ID        string     `gorm:"type:text;primaryKey" json:"id"`
+	Text      string     `gorm:"type:text;not null" json:"text"`
+	Rarity    string     `gorm:"type:text;not null" json:"rarity"`
+	Points    int        `gorm:"not null" json:"points"`
+	RetiredAt *time.Time `json:"retired_at,omitempty"` // retiré du catalogue (plus de rencontre)
+	Captures  []Capture  `gorm:"foreignKey:WordID" json:"captures,omitempty"`
+}
+
+// WordCatalogVersion modèle GORM pour la table word_catalog_versions
+type WordCatalogVersion struct {
+	Version  int       `gorm:"primaryKey;autoIncrement:false"`
+	Checksum string    `gorm:"type:text;not null"`
+	Added    int       `gorm:"not null"`
+	Updated  int       `gorm:"not null"`
+	Retired  int       `gorm:"not null"`
+	Restored int       `gorm:"not null"`
+	SyncedAt time.Time `gorm:"not null;default:now()"`
 }
 
 // Capture modèle GORM pour la table captures
@@ -55,3 +68,71 @@ func (c *Capture) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// Webhook modèle GORM pour la table webhooks
+type Webhook struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	URL       string    `gorm:"type:text;not null" json:"url"`
+	Secret    string    `gorm:"type:text;not null" json:"-"`
+	Events    string    `gorm:"type:text;not null" json:"events"` // séparés par des virgules
+	Active    bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt time.Time `gorm:"not null;default:now()" json:"created_at"`
+}
+
+// BeforeCreate génère un UUID avant la création
+func (w *Webhook) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// WebhookDelivery modèle GORM pour la table webhook_deliveries
+type WebhookDelivery struct {
+	ID          string     `gorm:"type:uuid;primaryKey" json:"id"`
+	WebhookID   string     `gorm:"type:uuid;not null;index:webhook_deliveries_webhook_idx" json:"webhook_id"`
+	Event       string     `gorm:"type:text;not null" json:"event"`
+	Payload     string     `gorm:"type:text;not null" json:"payload"`
+	Status      string     `gorm:"type:text;not null" json:"status"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	StatusCode  int        `gorm:"not null;default:0" json:"status_code"`
+	LastError   string     `gorm:"type:text;not null;default:''" json:"last_error"`
+	CreatedAt   time.Time  `gorm:"not null;default:now();index:webhook_deliveries_webhook_idx,sort:desc" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"not null;default:now()" json:"updated_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+
+	// Relations
+	Webhook Webhook `gorm:"foreignKey:WebhookID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// PlayerToken modèle GORM pour la table player_tokens (empreinte du jeton seulement)
+type PlayerToken struct {
+	ID        string     `gorm:"type:uuid;primaryKey" json:"id"`
+	PlayerID  string     `gorm:"type:uuid;not null;index:player_tokens_player_idx" json:"player_id"`
+	TokenHash string     `gorm:"type:text;not null;unique" json:"-"`
+	CreatedAt time.Time  `gorm:"not null;default:now()" json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	// Relations
+	Player Player `gorm:"foreignKey:PlayerID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// RateLimitBucket modèle GORM pour la table rate_limit_buckets (seaux à jetons)
+type RateLimitBucket struct {
+	Key       string    `gorm:"type:text;primaryKey"`
+	Tokens    float64   `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// RateLimitCounter modèle GORM pour la table rate_limit_counters
+type RateLimitCounter struct {
+	Key       string    `gorm:"type:text;primaryKey"`
+	Count     int       `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+}
+
+// RateLimitBlock modèle GORM pour la table rate_limit_blocks (refroidissements)
+type RateLimitBlock struct {
+	Key          string    `gorm:"type:text;primaryKey"`
+	BlockedUntil time.Time `gorm:"not null"`
+}
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// bucket état d'un seau à jetons
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// counter compteur remis à zéro à expiration
+type counter struct {
+	count   int
+	expires time.Time
+}
+
+// MemoryStore état des limiteurs en mémoire (une seule instance du serveur)
+type MemoryStore struct {
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	counters map[string]*counter
+	blocks   map[string]time.Time
+}
+
+// NewMemoryStore crée un état de limiteurs en mémoire
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets:  make(map[string]*bucket),
+		counters: make(map[string]*counter),
+		blocks:   make(map[string]time.Time),
+	}
+}
+
+// Take implémente Store
+func (s *MemoryStore) Take(ctx context.Context, key string, rule Rule, now time.Time) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, exists := s.buckets[key]
+	if !exists {
+		b = &bucket{tokens: float64(rule.Burst), last: now}
+		s.buckets[key] = b
+	}
+
+	ok, tokens, wait := rule.refill(b.tokens, b.last, now)
+	b.tokens = tokens
+	b.last = now
+	return ok, wait, nil
+}
+
+// Incr implémente Store
+func (s *MemoryStore) Incr(ctx context.Context, key string, ttl time.Duration, now time.Time) (int, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, exists := s.counters[key]
+	if !exists || !c.expires.After(now) {
+		c = &counter{expires: now.Add(ttl)}
+		s.counters[key] = c
+	}
+	c.count++
+	return c.count, c.expires, nil
+}
+
+// Block implémente Store
+func (s *MemoryStore) Block(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if until.After(s.blocks[key]) {
+		s.blocks[key] = until
+	}
+	return nil
+}
+
+// BlockedUntil implémente Store
+func (s *MemoryStore) BlockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.blocks[key], nil
+}
+
+// Cleanup implémente Store
+func (s *MemoryStore) Cleanup(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, b := range s.buckets {
+		if now.Sub(b.last) > idleTTL {
+			delete(s.buckets, key)
+		}
+	}
+	for key, c := range s.counters {
+		if !c.expires.After(now) {
+			delete(s.counters, key)
+		}
+	}
+	for key, until := range s.blocks {
+		if !until.After(now) {
+			delete(s.blocks, key)
+		}
+	}
+	return nil
+}
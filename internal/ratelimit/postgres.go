@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PostgresStore état des limiteurs partagé entre plusieurs instances via
+// Postgres (tables rate_limit_buckets, rate_limit_counters, rate_limit_blocks)
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore crée un état de limiteurs sur la base fournie
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Take implémente Store : le seau est verrouillé (FOR UPDATE) le temps du calcul
+func (s *PostgresStore) Take(ctx context.Context, key string, rule Rule, now time.Time) (bool, time.Duration, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, 0, fmt.Errorf("erreur transaction seau: %w", err)
+	}
+	defer tx.Rollback()
+
+	insertQuery := `INSERT INTO rate_limit_buckets (key, tokens, updated_at) VALUES ($1, $2, $3) ON CONFLICT (key) DO NOTHING`
+	if _, err := tx.ExecContext(ctx, insertQuery, key, float64(rule.Burst), now); err != nil {
+		return false, 0, fmt.Errorf("erreur création seau: %w", err)
+	}
+
+	var tokens float64
+	var last time.Time
+	selectQuery := `SELECT tokens, updated_at FROM rate_limit_buckets WHERE key = $1 FOR UPDATE`
+	if err := tx.QueryRowContext(ctx, selectQuery, key).Scan(&tokens, &last); err != nil {
+		return false, 0, fmt.Errorf("erreur lecture seau: %w", err)
+	}
+
+	ok, tokens, wait := rule.refill(tokens, last, now)
+
+	updateQuery := `UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3 WHERE key = $1`
+	if _, err := tx.ExecContext(ctx, updateQuery, key, tokens, now); err != nil {
+		return false, 0, fmt.Errorf("erreur mise à jour seau: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, 0, fmt.Errorf("erreur commit seau: %w", err)
+	}
+	return ok, wait, nil
+}
+
+// Incr implémente Store
+func (s *PostgresStore) Incr(ctx context.Context, key string, ttl time.Duration, now time.Time) (int, time.Time, error) {
+	query := `
+		INSERT INTO rate_limit_counters (key, count, expires_at) VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE SET
+			count = CASE WHEN rate_limit_counters.expires_at <= $3 THEN 1 ELSE rate_limit_counters.count + 1 END,
+			expires_at = CASE WHEN rate_limit_counters.expires_at <= $3 THEN $2 ELSE rate_limit_counters.expires_at END
+		RETURNING count, expires_at`
+
+	var count int
+	var expires time.Time
+	if err := s.db.QueryRowContext(ctx, query, key, now.Add(ttl), now).Scan(&count, &expires); err != nil {
+		return 0, time.Time{}, fmt.Errorf("erreur compteur: %w", err)
+	}
+	return count, expires, nil
+}
+
+// Block implémente Store
+func (s *PostgresStore) Block(ctx context.Context, key string, until time.Time) error {
+	query := `
+		INSERT INTO rate_limit_blocks (key, blocked_until) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET blocked_until = GREATEST(rate_limit_blocks.blocked_until, EXCLUDED.blocked_until)`
+
+	if _, err := s.db.ExecContext(ctx, query, key, until); err != nil {
+		return fmt.Errorf("erreur blocage: %w", err)
+	}
+	return nil
+}
+
+// BlockedUntil implémente Store
+func (s *PostgresStore) BlockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error) {
+	var until time.Time
+	err := s.db.QueryRowContext(ctx, `SELECT blocked_until FROM rate_limit_blocks WHERE key = $1`, key).Scan(&until)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("erreur lecture blocage: %w", err)
+	}
+	return until, nil
+}
+
+// Cleanup implémente Store
+func (s *PostgresStore) Cleanup(ctx context.Context, now time.Time) error {
+	queries := []struct {
+		query string
+		arg   time.Time
+	}{
+		{`DELETE FROM rate_limit_buckets WHERE updated_at < $1`, now.Add(-idleTTL)},
+		{`DELETE FROM rate_limit_counters WHERE expires_at <= $1`, now},
+		{`DELETE FROM rate_limit_blocks WHERE blocked_until <= $1`, now},
+	}
+
+	for _, q := range queries {
+		if _, err := s.db.ExecContext(ctx, q.query, q.arg); err != nil {
+			return fmt.Errorf("erreur purge: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Package ratelimit protège les tentatives de capture contre la force brute :
+// seaux à jetons par joueur et par IP, plafond de tentatives par spawn et
+// pénalité de refroidissement après une mauvaise réponse.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+)
+
+// Durée d'inactivité après laquelle un état est purgé
+const idleTTL = 10 * time.Minute
+
+// Rule règle d'un seau à jetons : Rate jetons par seconde, Burst jetons au maximum.
+// Une règle avec Burst <= 0 est désactivée.
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute construit une règle de n requêtes par minute avec une rafale de burst
+func PerMinute(n, burst int) Rule {
+	return Rule{Rate: float64(n) / 60, Burst: burst}
+}
+
+// Enabled indique si la règle limite quelque chose
+func (r Rule) Enabled() bool {
+	return r.Burst > 0
+}
+
+// refill calcule l'état d'un seau après consommation d'un jeton. Retourne si
+// le jeton est accordé, les jetons restants et le délai avant le prochain jeton.
+func (r Rule) refill(tokens float64, last, now time.Time) (bool, float64, time.Duration) {
+	if elapsed := now.Sub(last).Seconds(); elapsed > 0 {
+		tokens = math.Min(float64(r.Burst), tokens+elapsed*r.Rate)
+	}
+	if tokens >= 1 {
+		return true, tokens - 1, 0
+	}
+	if r.Rate <= 0 {
+		return false, tokens, idleTTL
+	}
+	wait := time.Duration((1 - tokens) / r.Rate * float64(time.Second))
+	return false, tokens, wait
+}
+
+// Store état partagé des limiteurs (mémoire ou Postgres)
+type Store interface {
+	// Take consomme un jeton du seau key ; sinon retourne le délai d'attente
+	Take(ctx context.Context, key string, rule Rule, now time.Time) (bool, time.Duration, error)
+	// Incr incrémente le compteur key, remis à zéro après ttl ; retourne sa valeur et son expiration
+	Incr(ctx context.Context, key string, ttl time.Duration, now time.Time) (int, time.Time, error)
+	// Block bloque key jusqu'à until
+	Block(ctx context.Context, key string, until time.Time) error
+	// BlockedUntil retourne la fin du blocage de key (zéro si aucun)
+	BlockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error)
+	// Cleanup purge les états expirés ou inactifs
+	Cleanup(ctx context.Context, now time.Time) error
+}
+
+// Config réglages du limiteur
+type Config struct {
+	Player              Rule
+	IP                  Rule
+	MaxAttemptsPerSpawn int           // 0 : pas de plafond
+	SpawnTTL            time.Duration // durée de vie d'un spawn (auto-fuite)
+	WrongAnswerCooldown time.Duration // 0 : pas de refroidissement
+}
+
+// Decision résultat d'une vérification
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+	Reason     string
+}
+
+// Raisons de refus
+const (
+	ReasonIP       = "trop de requêtes pour cette adresse"
+	ReasonPlayer   = "trop de tentatives pour ce joueur"
+	ReasonCooldown = "refroidissement après une mauvaise réponse"
+	ReasonSpawn    = "plafond de tentatives atteint pour ce WordMon"
+)
+
+// allowed décision favorable
+var allowed = Decision{Allowed: true}
+
+// Limiter applique la configuration sur un Store
+type Limiter struct {
+	store Store
+	cfg   Config
+	now   func() time.Time
+}
+
+// NewLimiter crée un limiteur
+func NewLimiter(store Store, cfg Config) *Limiter {
+	return &Limiter{store: store, cfg: cfg, now: time.Now}
+}
+
+// Config retourne la configuration du limiteur
+func (l *Limiter) Config() Config {
+	return l.cfg
+}
+
+// Start purge périodiquement les états expirés jusqu'à l'annulation du contexte
+func (l *Limiter) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := l.store.Cleanup(ctx, l.now()); err != nil {
+					log.Printf("[ratelimit] Erreur purge: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// AllowIP vérifie le seau de l'adresse IP
+func (l *Limiter) AllowIP(ctx context.Context, ip string) Decision {
+	return l.take(ctx, "ip:"+ip, l.cfg.IP, ReasonIP)
+}
+
+// AllowPlayer vérifie le refroidissement puis le seau du joueur
+func (l *Limiter) AllowPlayer(ctx context.Context, playerID string) Decision {
+	if l.cfg.WrongAnswerCooldown > 0 {
+		now := l.now()
+		until, err := l.store.BlockedUntil(ctx, "cooldown:"+playerID, now)
+		if err != nil {
+			// En cas de panne du backend, les tentatives restent possibles
+			log.Printf("[ratelimit] Erreur lecture refroidissement: %v", err)
+		} else if until.After(now) {
+			return Decision{RetryAfter: until.Sub(now), Reason: ReasonCooldown}
+		}
+	}
+	return l.take(ctx, "player:"+playerID, l.cfg.Player, ReasonPlayer)
+}
+
+// AllowSpawnAttempt compte la tentative du joueur sur le spawn et applique le plafond
+func (l *Limiter) AllowSpawnAttempt(ctx context.Context, spawnID, playerID string) Decision {
+	if l.cfg.MaxAttemptsPerSpawn <= 0 {
+		return allowed
+	}
+
+	now := l.now()
+	count, expires, err := l.store.Incr(ctx, "spawn:"+spawnID+":"+playerID, l.cfg.SpawnTTL, now)
+	if err != nil {
+		log.Printf("[ratelimit] Erreur compteur spawn: %v", err)
+		return allowed
+	}
+	if count > l.cfg.MaxAttemptsPerSpawn {
+		return Decision{RetryAfter: expires.Sub(now), Reason: ReasonSpawn}
+	}
+	return allowed
+}
+
+// PenalizeWrongAnswer applique le refroidissement après une mauvaise réponse
+func (l *Limiter) PenalizeWrongAnswer(ctx context.Context, playerID string) {
+	if l.cfg.WrongAnswerCooldown <= 0 {
+		return
+	}
+	if err := l.store.Block(ctx, "cooldown:"+playerID, l.now().Add(l.cfg.WrongAnswerCooldown)); err != nil {
+		log.Printf("[ratelimit] Erreur refroidissement: %v", err)
+	}
+}
+
+// take consomme un jeton du seau key selon rule
+func (l *Limiter) take(ctx context.Context, key string, rule Rule, reason string) Decision {
+	if !rule.Enabled() {
+		return allowed
+	}
+
+	ok, wait, err := l.store.Take(ctx, key, rule, l.now())
+	if err != nil {
+		log.Printf("[ratelimit] Erreur seau %s: %v", key, err)
+		return allowed
+	}
+	if !ok {
+		return Decision{RetryAfter: wait, Reason: reason}
+	}
+	return allowed
+}
+
+// RetryAfterSeconds arrondit un délai à la seconde supérieure (au moins 1)
+// pour l'en-tête Retry-After
+func RetryAfterSeconds(d time.Duration) int {
+	seconds := int(math.Ceil(d.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+// String décrit la configuration pour les logs
+func (c Config) String() string {
+	return fmt.Sprintf("joueur=%.2f/s (rafale %d), ip=%.2f/s (rafale %d), %d tentatives/spawn, refroidissement %s",
+		c.Player.Rate, c.Player.Burst, c.IP.Rate, c.IP.Burst, c.MaxAttemptsPerSpawn, c.WrongAnswerCooldown)
+}
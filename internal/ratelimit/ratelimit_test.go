@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// newTestLimiter crée un limiteur mémoire dont l'horloge est contrôlée par le test
+func newTestLimiter(cfg Config) (*Limiter, *time.Time) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(NewMemoryStore(), cfg)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestPlayerBucketRefills(t *testing.T) {
+	limiter, now := newTestLimiter(Config{Player: PerMinute(60, 2)})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if d := limiter.AllowPlayer(ctx, "p1"); !d.Allowed {
+			t.Fatalf("tentative %d refusée dans la rafale", i+1)
+		}
+	}
+	d := limiter.AllowPlayer(ctx, "p1")
+	if d.Allowed || d.Reason != ReasonPlayer || d.RetryAfter != time.Second {
+		t.Fatalf("attendu un refus d'une seconde, obtenu %+v", d)
+	}
+
+	// Les autres joueurs ont leur propre seau
+	if d := limiter.AllowPlayer(ctx, "p2"); !d.Allowed {
+		t.Error("le seau d'un autre joueur ne doit pas être affecté")
+	}
+
+	*now = now.Add(time.Second)
+	if d := limiter.AllowPlayer(ctx, "p1"); !d.Allowed {
+		t.Errorf("jeton attendu après recharge, obtenu %+v", d)
+	}
+}
+
+func TestSpawnAttemptCap(t *testing.T) {
+	limiter, now := newTestLimiter(Config{MaxAttemptsPerSpawn: 2, SpawnTTL: 30 * time.Second})
+	ctx := context.Background()
+
+	limiter.AllowSpawnAttempt(ctx, "w1", "p1")
+	limiter.AllowSpawnAttempt(ctx, "w1", "p1")
+	d := limiter.AllowSpawnAttempt(ctx, "w1", "p1")
+	if d.Allowed || d.Reason != ReasonSpawn {
+		t.Fatalf("plafond attendu, obtenu %+v", d)
+	}
+	if d := limiter.AllowSpawnAttempt(ctx, "w2", "p1"); !d.Allowed {
+		t.Error("un autre spawn ne doit pas être plafonné")
+	}
+
+	// Le compteur expire avec le spawn
+	*now = now.Add(30 * time.Second)
+	if d := limiter.AllowSpawnAttempt(ctx, "w1", "p1"); !d.Allowed {
+		t.Errorf("compteur attendu remis à zéro, obtenu %+v", d)
+	}
+}
+
+func TestWrongAnswerCooldown(t *testing.T) {
+	limiter, now := newTestLimiter(Config{WrongAnswerCooldown: 2 * time.Second})
+	ctx := context.Background()
+
+	limiter.PenalizeWrongAnswer(ctx, "p1")
+	d := limiter.AllowPlayer(ctx, "p1")
+	if d.Allowed || d.Reason != ReasonCooldown || RetryAfterSeconds(d.RetryAfter) != 2 {
+		t.Fatalf("refroidissement attendu, obtenu %+v", d)
+	}
+
+	*now = now.Add(2 * time.Second)
+	if d := limiter.AllowPlayer(ctx, "p1"); !d.Allowed {
+		t.Errorf("refroidissement attendu terminé, obtenu %+v", d)
+	}
+}
@@ -0,0 +1,545 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// CatalogWord mot du catalogue en base ; un mot retiré reste lisible
+// (GetWord) pour les captures passées mais n'apparaît plus en rencontre
+type CatalogWord struct {
+	core.Word
+	Retired bool
+}
+
+// WordChange mot dont le texte, la rareté ou les points ont changé
+type WordChange struct {
+	Before core.Word
+	After  core.Word
+}
+
+// CatalogDiff écart entre le catalogue de la config et celui de la base
+type CatalogDiff struct {
+	Added     []core.Word  // absents de la base
+	Updated   []WordChange // modifiés dans la config
+	Retired   []core.Word  // absents de la config : retirés, pas supprimés
+	Restored  []core.Word  // retirés puis revenus dans la config
+	Unchanged int
+}
+
+// Empty indique que la base est à jour
+func (d CatalogDiff) Empty() bool {
+	return len(d.Added)+len(d.Updated)+len(d.Retired)+len(d.Restored) == 0
+}
+
+// CatalogSync résultat d'une synchronisation du catalogue
+type CatalogSync struct {
+	CatalogDiff
+	Checksum string // empreinte du catalogue de la config
+	Version  int    // version enregistrée (la nouvelle si Applied)
+	Applied  bool
+}
+
+// CatalogVersion version enregistrée du catalogue : une par synchronisation appliquée
+type CatalogVersion struct {
+	Version  int       `json:"version"`
+	Checksum string    `json:"checksum"`
+	Added    int       `json:"added"`
+	Updated  int       `json:"updated"`
+	Retired  int       `json:"retired"`
+	Restored int       `json:"restored"`
+	SyncedAt time.Time `json:"syncedAt"`
+}
+
+// CatalogStore synchronisation du catalogue des mots avec la config (Seed
+// n'ajoute que les mots absents : il ne modifie ni ne retire rien)
+type CatalogStore interface {
+	// SyncWords compare words au catalogue en base ; si apply, insère,
+	// met à jour, retire et restaure les mots puis enregistre une nouvelle
+	// version du catalogue, dans une seule transaction
+	SyncWords(ctx context.Context, words []core.Word, apply bool) (*CatalogSync, error)
+}
+
+var (
+	_ CatalogStore = (*MemoryStore)(nil)
+	_ CatalogStore = (*FileStore)(nil)
+	_ CatalogStore = (*SQLStore)(nil)
+	_ CatalogStore = (*GORMStore)(nil)
+)
+
+// DiffCatalog calcule l'écart entre le catalogue current et la config wanted
+func DiffCatalog(current []CatalogWord, wanted []core.Word) CatalogDiff {
+	var diff CatalogDiff
+	byID := make(map[string]CatalogWord, len(current))
+	for _, word := range current {
+		byID[word.ID] = word
+	}
+
+	seen := make(map[string]bool, len(wanted))
+	for _, word := range wanted {
+		seen[word.ID] = true
+		existing, exists := byID[word.ID]
+		switch {
+		case !exists:
+			diff.Added = append(diff.Added, word)
+		case existing.Retired:
+			diff.Restored = append(diff.Restored, word)
+		case existing.Word != word:
+			diff.Updated = append(diff.Updated, WordChange{Before: existing.Word, After: word})
+		default:
+			diff.Unchanged++
+		}
+	}
+	for _, word := range current {
+		if !seen[word.ID] && !word.Retired {
+			diff.Retired = append(diff.Retired, word.Word)
+		}
+	}
+	return diff
+}
+
+// CatalogChecksum empreinte SHA-256 d'un catalogue, indépendante de l'ordre
+func CatalogChecksum(words []core.Word) string {
+	sorted := append([]core.Word(nil), words...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	h := sha256.New()
+	for _, word := range sorted {
+		fmt.Fprintf(h, "%s\t%s\t%s\t%d\n", word.ID, word.Text, word.Rarity, word.Points)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// validateCatalog refuse un catalogue vide ou avec des IDs en double
+func validateCatalog(words []core.Word) error {
+	if len(words) == 0 {
+		return invalid("catalogue vide")
+	}
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		if word.ID == "" {
+			return invalid("mot sans ID: %s", word.Text)
+		}
+		if seen[word.ID] {
+			return invalid("mot en double: %s", word.ID)
+		}
+		seen[word.ID] = true
+	}
+	return nil
+}
+
+// sqlSyncWords synchronise le catalogue dans Postgres (partagée par SQLStore
+// et GORMStore : même transaction, même verrou sur words)
+func sqlSyncWords(ctx context.Context, db *sql.DB, words []core.Word, apply bool) (*CatalogSync, error) {
+	if err := validateCatalog(words); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, wrapErr(err, "erreur transaction catalogue")
+	}
+	defer tx.Rollback()
+
+	// Une seule synchronisation à la fois ; les lectures restent possibles
+	if _, err := tx.ExecContext(ctx, `LOCK TABLE words IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return nil, wrapErr(err, "erreur verrou catalogue")
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, text, rarity, points, retired_at IS NOT NULL FROM words ORDER BY id`)
+	if err != nil {
+		return nil, wrapErr(err, "erreur lecture catalogue")
+	}
+	var current []CatalogWord
+	for rows.Next() {
+		var word CatalogWord
+		var rarityStr string
+		if err := rows.Scan(&word.ID, &word.Text, &rarityStr, &word.Points, &word.Retired); err != nil {
+			rows.Close()
+			return nil, wrapErr(err, "erreur lecture mot")
+		}
+		word.Rarity = core.Rarity(rarityStr)
+		current = append(current, word)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture catalogue")
+	}
+
+	sync := &CatalogSync{CatalogDiff: DiffCatalog(current, words), Checksum: CatalogChecksum(words)}
+	var lastChecksum string
+	err = tx.QueryRowContext(ctx, `SELECT version, checksum FROM word_catalog_versions ORDER BY version DESC LIMIT 1`).
+		Scan(&sync.Version, &lastChecksum)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, wrapErr(err, "erreur lecture version catalogue")
+	}
+	// Rien à écrire si la base est à jour et que cette config est déjà enregistrée
+	if !apply || (sync.Empty() && lastChecksum == sync.Checksum) {
+		return sync, nil
+	}
+
+	for _, word := range sync.Added {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO words (id, text, rarity, points) VALUES ($1, $2, $3, $4)`,
+			word.ID, word.Text, string(word.Rarity), word.Points); err != nil {
+			return nil, wrapErr(err, "erreur insertion mot %s", word.ID)
+		}
+	}
+	changed := make([]core.Word, 0, len(sync.Updated)+len(sync.Restored))
+	for _, change := range sync.Updated {
+		changed = append(changed, change.After)
+	}
+	changed = append(changed, sync.Restored...)
+	for _, word := range changed {
+		if _, err := tx.ExecContext(ctx, `UPDATE words SET text = $1, rarity = $2, points = $3, retired_at = NULL WHERE id = $4`,
+			word.Text, string(word.Rarity), word.Points, word.ID); err != nil {
+			return nil, wrapErr(err, "erreur mise à jour mot %s", word.ID)
+		}
+	}
+	for _, word := range sync.Retired {
+		if _, err := tx.ExecContext(ctx, `UPDATE words SET retired_at = NOW() WHERE id = $1`, word.ID); err != nil {
+			return nil, wrapErr(err, "erreur retrait mot %s", word.ID)
+		}
+	}
+
+	sync.Version++
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO word_catalog_versions (version, checksum, added, updated, retired, restored)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		sync.Version, sync.Checksum, len(sync.Added), len(sync.Updated), len(sync.Retired), len(sync.Restored))
+	if err != nil {
+		return nil, wrapErr(err, "erreur enregistrement version catalogue")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, wrapErr(err, "erreur commit catalogue")
+	}
+	sync.Applied = true
+	return sync, nil
+}
+
+// sqlListCatalog lit le catalogue avec l'état de retrait de chaque mot
+func sqlListCatalog(ctx context.Context, db *sql.DB) ([]CatalogWord, error) {
+	rows, err := db.QueryContext(ctx, `SELECT id, text, rarity, points, retired_at IS NOT NULL FROM words ORDER BY id`)
+	if err != nil {
+		return nil, wrapErr(err, "erreur lecture catalogue")
+	}
+	defer rows.Close()
+
+	var words []CatalogWord
+	for rows.Next() {
+		var word CatalogWord
+		var rarityStr string
+		if err := rows.Scan(&word.ID, &word.Text, &rarityStr, &word.Points, &word.Retired); err != nil {
+			return nil, wrapErr(err, "erreur lecture mot")
+		}
+		word.Rarity = core.Rarity(rarityStr)
+		words = append(words, word)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture catalogue")
+	}
+	return words, nil
+}
+
+// sqlCatalogVersions lit l'historique des versions du catalogue
+func sqlCatalogVersions(ctx context.Context, db *sql.DB) ([]CatalogVersion, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT version, checksum, added, updated, retired, restored, synced_at
+		FROM word_catalog_versions ORDER BY version`)
+	if err != nil {
+		return nil, wrapErr(err, "erreur lecture versions catalogue")
+	}
+	defer rows.Close()
+
+	var versions []CatalogVersion
+	for rows.Next() {
+		var v CatalogVersion
+		if err := rows.Scan(&v.Version, &v.Checksum, &v.Added, &v.Updated, &v.Retired, &v.Restored, &v.SyncedAt); err != nil {
+			return nil, wrapErr(err, "erreur lecture version catalogue")
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture versions catalogue")
+	}
+	return versions, nil
+}
+
+// sqlRestoreCatalog insère les mots absents tels quels (retirés compris) et
+// les versions absentes de l'historique, dans une transaction
+func sqlRestoreCatalog(ctx context.Context, db *sql.DB, words []CatalogWord, versions []CatalogVersion) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return wrapErr(err, "erreur transaction catalogue")
+	}
+	defer tx.Rollback()
+
+	for _, word := range words {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO words (id, text, rarity, points, retired_at)
+			VALUES ($1, $2, $3, $4, CASE WHEN $5 THEN NOW() END)
+			ON CONFLICT (id) DO NOTHING`,
+			word.ID, word.Text, string(word.Rarity), word.Points, word.Retired); err != nil {
+			return wrapErr(err, "erreur insertion mot %s", word.ID)
+		}
+	}
+	for _, v := range versions {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO word_catalog_versions (version, checksum, added, updated, retired, restored, synced_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (version) DO NOTHING`,
+			v.Version, v.Checksum, v.Added, v.Updated, v.Retired, v.Restored, v.SyncedAt); err != nil {
+			return wrapErr(err, "erreur insertion version catalogue %d", v.Version)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return wrapErr(err, "erreur commit catalogue")
+	}
+	return nil
+}
+
+// lastCatalog dernière version enregistrée du catalogue (zéro si aucune) ;
+// verrou requis
+func (s *MemoryStore) lastCatalog() CatalogVersion {
+	if len(s.versions) == 0 {
+		return CatalogVersion{}
+	}
+	return s.versions[len(s.versions)-1]
+}
+
+// SyncWords compare words au catalogue ; si apply, l'applique et enregistre
+// une nouvelle version. Les points d'un mot modifié valent pour les captures
+// suivantes, l'XP déjà gagnée n'est pas recalculée.
+func (s *MemoryStore) SyncWords(ctx context.Context, words []core.Word, apply bool) (*CatalogSync, error) {
+	return s.syncWords(words, apply, time.Now().UTC())
+}
+
+// syncWords applique SyncWords avec la date de version at (celle du journal
+// pour le FileStore)
+func (s *MemoryStore) syncWords(words []core.Word, apply bool, at time.Time) (*CatalogSync, error) {
+	if err := validateCatalog(words); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := make([]CatalogWord, 0, len(s.words))
+	for _, word := range s.words {
+		current = append(current, CatalogWord{Word: word, Retired: s.retired[word.ID]})
+	}
+	sort.Slice(current, func(i, j int) bool { return current[i].ID < current[j].ID })
+
+	last := s.lastCatalog()
+	sync := &CatalogSync{CatalogDiff: DiffCatalog(current, words), Checksum: CatalogChecksum(words), Version: last.Version}
+	if !apply || (sync.Empty() && last.Checksum == sync.Checksum) {
+		return sync, nil
+	}
+
+	legendaryChanged := false
+	for _, word := range sync.Added {
+		s.words[word.ID] = word
+	}
+	for _, change := range sync.Updated {
+		s.words[change.After.ID] = change.After
+		if (change.Before.Rarity == core.Legendary) != (change.After.Rarity == core.Legendary) {
+			legendaryChanged = true
+		}
+	}
+	for _, word := range sync.Restored {
+		if (s.words[word.ID].Rarity == core.Legendary) != (word.Rarity == core.Legendary) {
+			legendaryChanged = true
+		}
+		s.words[word.ID] = word
+		delete(s.retired, word.ID)
+	}
+	for _, word := range sync.Retired {
+		s.retired[word.ID] = true
+	}
+	if legendaryChanged {
+		s.reindexLegendary()
+	}
+
+	sync.Version = last.Version + 1
+	s.versions = append(s.versions, CatalogVersion{
+		Version:  sync.Version,
+		Checksum: sync.Checksum,
+		Added:    len(sync.Added),
+		Updated:  len(sync.Updated),
+		Retired:  len(sync.Retired),
+		Restored: len(sync.Restored),
+		SyncedAt: at,
+	})
+	sync.Applied = true
+	return sync, nil
+}
+
+// reindexLegendary recompte les captures légendaires de chaque joueur après
+// un changement de rareté (verrou en écriture requis)
+func (s *MemoryStore) reindexLegendary() {
+	for id := range s.players {
+		legendary := 0
+		for _, capture := range s.captures[id] {
+			if s.words[capture.WordID].Rarity == core.Legendary {
+				legendary++
+			}
+		}
+		s.rankings[RankByLegendary].Set(id, legendary)
+	}
+}
+
+// SyncWords compare words au catalogue ; si apply, journalise le catalogue
+// voulu puis l'applique comme le MemoryStore
+func (s *FileStore) SyncWords(ctx context.Context, words []core.Word, apply bool) (*CatalogSync, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	plan, err := s.mem.SyncWords(ctx, words, false)
+	if err != nil || !apply || (plan.Empty() && s.mem.catalogChecksum() == plan.Checksum) {
+		return plan, err
+	}
+	if err := s.write(ctx, walRecord{Op: opWordsSynced, Words: words}); err != nil {
+		return nil, err
+	}
+	// Écritures sérialisées : l'état appliqué est celui du plan
+	plan.Version++
+	plan.Applied = true
+	return plan, nil
+}
+
+// catalogChecksum empreinte de la dernière version enregistrée du catalogue
+func (s *MemoryStore) catalogChecksum() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastCatalog().Checksum
+}
+
+// ListCatalog retourne le catalogue trié par ID, mots retirés compris
+func (s *MemoryStore) ListCatalog(ctx context.Context) ([]CatalogWord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	words := make([]CatalogWord, 0, len(s.words))
+	for _, word := range s.words {
+		words = append(words, CatalogWord{Word: word, Retired: s.retired[word.ID]})
+	}
+	sort.Slice(words, func(i, j int) bool { return words[i].ID < words[j].ID })
+	return words, nil
+}
+
+// ListCatalogVersions retourne l'historique des versions du catalogue
+func (s *MemoryStore) ListCatalogVersions(ctx context.Context) ([]CatalogVersion, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]CatalogVersion(nil), s.versions...), nil
+}
+
+// RestoreCatalog ajoute les mots absents tels quels (retirés compris) et les
+// versions absentes de l'historique
+func (s *MemoryStore) RestoreCatalog(ctx context.Context, words []CatalogWord, versions []CatalogVersion) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, word := range words {
+		if _, exists := s.words[word.ID]; exists {
+			continue
+		}
+		s.words[word.ID] = word.Word
+		if word.Retired {
+			s.retired[word.ID] = true
+		}
+	}
+	known := make(map[int]bool, len(s.versions))
+	for _, v := range s.versions {
+		known[v.Version] = true
+	}
+	for _, v := range versions {
+		if !known[v.Version] {
+			s.versions = append(s.versions, v)
+		}
+	}
+	sort.Slice(s.versions, func(i, j int) bool { return s.versions[i].Version < s.versions[j].Version })
+	return nil
+}
+
+// ListCatalog retourne le catalogue trié par ID, mots retirés compris
+func (s *FileStore) ListCatalog(ctx context.Context) ([]CatalogWord, error) {
+	return s.mem.ListCatalog(ctx)
+}
+
+// ListCatalogVersions retourne l'historique des versions du catalogue
+func (s *FileStore) ListCatalogVersions(ctx context.Context) ([]CatalogVersion, error) {
+	return s.mem.ListCatalogVersions(ctx)
+}
+
+// RestoreCatalog journalise puis applique le catalogue restauré
+func (s *FileStore) RestoreCatalog(ctx context.Context, words []CatalogWord, versions []CatalogVersion) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(ctx, walRecord{Op: opCatalogRestored, Catalog: words, Versions: versions})
+}
+
+// ListCatalog retourne le catalogue trié par ID, mots retirés compris
+func (s *SQLStore) ListCatalog(ctx context.Context) ([]CatalogWord, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+	return sqlListCatalog(ctx, s.db)
+}
+
+// ListCatalogVersions retourne l'historique des versions du catalogue
+func (s *SQLStore) ListCatalogVersions(ctx context.Context) ([]CatalogVersion, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+	return sqlCatalogVersions(ctx, s.db)
+}
+
+// RestoreCatalog insère les mots et les versions absents, dans une transaction
+func (s *SQLStore) RestoreCatalog(ctx context.Context, words []CatalogWord, versions []CatalogVersion) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+	return sqlRestoreCatalog(ctx, s.db, words, versions)
+}
+
+// ListCatalog retourne le catalogue trié par ID, mots retirés compris
+// (requête SQL partagée avec SQLStore)
+func (s *GORMStore) ListCatalog(ctx context.Context) ([]CatalogWord, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	db, err := s.DB()
+	if err != nil {
+		return nil, wrapErr(err, "erreur connexion DB")
+	}
+	return sqlListCatalog(ctx, db)
+}
+
+// ListCatalogVersions retourne l'historique des versions du catalogue
+func (s *GORMStore) ListCatalogVersions(ctx context.Context) ([]CatalogVersion, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	db, err := s.DB()
+	if err != nil {
+		return nil, wrapErr(err, "erreur connexion DB")
+	}
+	return sqlCatalogVersions(ctx, db)
+}
+
+// RestoreCatalog insère les mots et les versions absents, dans une transaction
+func (s *GORMStore) RestoreCatalog(ctx context.Context, words []CatalogWord, versions []CatalogVersion) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	db, err := s.DB()
+	if err != nil {
+		return wrapErr(err, "erreur connexion DB")
+	}
+	return sqlRestoreCatalog(ctx, db, words, versions)
+}
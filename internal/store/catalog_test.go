@@ -0,0 +1,120 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+func TestDiffCatalog(t *testing.T) {
+	current := []CatalogWord{
+		{Word: core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}},
+		{Word: core.Word{ID: "c_2", Text: "lune", Rarity: core.Common, Points: 5}},
+		{Word: core.Word{ID: "r_1", Text: "fusée", Rarity: core.Rare, Points: 20}},
+		{Word: core.Word{ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 100}, Retired: true},
+		{Word: core.Word{ID: "l_2", Text: "phénix", Rarity: core.Legendary, Points: 100}, Retired: true},
+	}
+	wanted := []core.Word{
+		{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5},
+		{ID: "r_1", Text: "fusée", Rarity: core.Legendary, Points: 100},
+		{ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 100},
+		{ID: "c_3", Text: "code", Rarity: core.Common, Points: 5},
+	}
+
+	diff := DiffCatalog(current, wanted)
+	if diff.Unchanged != 1 {
+		t.Errorf("1 mot inchangé attendu, obtenu %d", diff.Unchanged)
+	}
+	if len(diff.Added) != 1 || diff.Added[0].ID != "c_3" {
+		t.Errorf("c_3 ajouté attendu: %+v", diff.Added)
+	}
+	if len(diff.Updated) != 1 || diff.Updated[0].Before.Rarity != core.Rare || diff.Updated[0].After.Points != 100 {
+		t.Errorf("r_1 modifié attendu: %+v", diff.Updated)
+	}
+	if len(diff.Restored) != 1 || diff.Restored[0].ID != "l_1" {
+		t.Errorf("l_1 restauré attendu: %+v", diff.Restored)
+	}
+	// l_2, déjà retiré, n'est pas retiré une seconde fois
+	if len(diff.Retired) != 1 || diff.Retired[0].ID != "c_2" {
+		t.Errorf("c_2 retiré attendu: %+v", diff.Retired)
+	}
+
+	if again := DiffCatalog([]CatalogWord{{Word: wanted[0]}}, wanted[:1]); !again.Empty() {
+		t.Errorf("catalogue à jour: écart vide attendu, obtenu %+v", again)
+	}
+}
+
+func TestCatalogChecksum(t *testing.T) {
+	words := []core.Word{
+		{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5},
+		{ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 100},
+	}
+	reversed := []core.Word{words[1], words[0]}
+	if CatalogChecksum(words) != CatalogChecksum(reversed) {
+		t.Error("l'empreinte ne doit pas dépendre de l'ordre")
+	}
+	changed := []core.Word{words[0], {ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 50}}
+	if CatalogChecksum(words) == CatalogChecksum(changed) {
+		t.Error("les points doivent changer l'empreinte")
+	}
+
+	if err := validateCatalog(append(words, words[0])); !errors.Is(err, ErrInvalid) {
+		t.Errorf("mot en double: ErrInvalid attendu, obtenu %v", err)
+	}
+}
+
+func TestFileStoreSyncWords(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("ouverture: %v", err)
+	}
+	aliceID := fillFileStore(t, s)
+
+	// dragon devient Rare, chat est retiré, lune arrive
+	wanted := []core.Word{
+		{ID: "l_1", Text: "dragon", Rarity: core.Rare, Points: 20},
+		{ID: "c_2", Text: "lune", Rarity: core.Common, Points: 5},
+	}
+	plan, err := s.SyncWords(ctx, wanted, false)
+	if err != nil || plan.Applied || len(plan.Added) != 1 || len(plan.Updated) != 1 || len(plan.Retired) != 1 {
+		t.Fatalf("plan inattendu: %+v (%v)", plan, err)
+	}
+	if _, err := s.RandomByRarity(ctx, string(core.Legendary)); err != nil {
+		t.Error("simulation: le catalogue ne doit pas changer")
+	}
+
+	synced, err := s.SyncWords(ctx, wanted, true)
+	if err != nil || !synced.Applied || synced.Version != 1 {
+		t.Fatalf("version 1 appliquée attendue: %+v (%v)", synced, err)
+	}
+	if again, _ := s.SyncWords(ctx, wanted, true); again.Applied {
+		t.Error("catalogue à jour: aucune nouvelle version attendue")
+	}
+	s.wal.Close()
+
+	// Rejeu du journal puis compaction : même catalogue
+	for range 2 {
+		reopened, err := NewFileStore(dir)
+		if err != nil {
+			t.Fatalf("réouverture: %v", err)
+		}
+		if word, err := reopened.RandomByRarity(ctx, string(core.Common)); err != nil || word.ID != "c_2" {
+			t.Errorf("seul c_2 doit apparaître en Common: %+v (%v)", word, err)
+		}
+		if word, err := reopened.GetWord(ctx, "c_1"); err != nil || word.Text != "chat" {
+			t.Errorf("mot retiré toujours lisible attendu: %v", err)
+		}
+		if page, _ := reopened.Leaderboard(ctx, LeaderboardQuery{By: RankByLegendary}); len(page.Players) != 1 || page.Players[0].Score != 0 {
+			t.Errorf("captures légendaires de %s non recomptées: %+v", aliceID, page.Players)
+		}
+		if plan, _ := reopened.SyncWords(ctx, wanted, false); !plan.Empty() || plan.Version != 1 {
+			t.Errorf("catalogue version 1 à jour attendu: %+v", plan)
+		}
+		if err := reopened.Close(); err != nil {
+			t.Fatalf("fermeture: %v", err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// Catégories d'erreurs des stores, à tester avec errors.Is. L'erreur du
+// driver (pq, pgx, GORM) reste accessible dans la chaîne.
+var (
+	ErrNotFound    = errors.New("introuvable")
+	ErrConflict    = errors.New("conflit")
+	ErrUnavailable = errors.New("stockage indisponible")
+	ErrInvalid     = errors.New("donnée invalide")
+)
+
+// storeError erreur d'un repository : message, catégorie et cause éventuelle
+type storeError struct {
+	msg  string
+	kind error
+	err  error
+}
+
+func (e *storeError) Error() string {
+	if e.err == nil {
+		return e.msg
+	}
+	return e.msg + ": " + e.err.Error()
+}
+
+func (e *storeError) Unwrap() []error {
+	errs := make([]error, 0, 2)
+	if e.kind != nil {
+		errs = append(errs, e.kind)
+	}
+	if e.err != nil {
+		errs = append(errs, e.err)
+	}
+	return errs
+}
+
+// notFound retourne une erreur ErrNotFound
+func notFound(format string, args ...any) error {
+	return &storeError{msg: fmt.Sprintf(format, args...), kind: ErrNotFound}
+}
+
+// tokenNotFound retourne une erreur ErrNotFound pour un jeton inconnu,
+// reconnue aussi comme auth.ErrTokenNotFound (contrat de auth.TokenStore)
+func tokenNotFound(id string) error {
+	return &storeError{msg: "jeton " + id, kind: ErrNotFound, err: auth.ErrTokenNotFound}
+}
+
+// conflict retourne une erreur ErrConflict
+func conflict(format string, args ...any) error {
+	return &storeError{msg: fmt.Sprintf(format, args...), kind: ErrConflict}
+}
+
+// invalid retourne une erreur ErrInvalid
+func invalid(format string, args ...any) error {
+	return &storeError{msg: fmt.Sprintf(format, args...), kind: ErrInvalid}
+}
+
+// wrapErr enveloppe une erreur du driver avec sa catégorie
+func wrapErr(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	return &storeError{msg: fmt.Sprintf(format, args...), kind: classify(err), err: err}
+}
+
+// classify retourne la catégorie d'une erreur du driver (nil si inconnue)
+func classify(err error) error {
+	var pqErr *pq.Error
+	var pgErr *pgconn.PgError
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrConflict),
+		errors.Is(err, ErrUnavailable), errors.Is(err, ErrInvalid):
+		return nil // déjà catégorisée
+	case errors.Is(err, sql.ErrNoRows), errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrConflict
+	case errors.As(err, &pqErr):
+		return classifySQLState(string(pqErr.Code))
+	case errors.As(err, &pgErr):
+		return classifySQLState(pgErr.Code)
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone),
+		errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled), errors.As(err, &netErr):
+		return ErrUnavailable
+	}
+	return nil
+}
+
+// classifySQLState catégorise un code SQLSTATE de Postgres
+func classifySQLState(code string) error {
+	switch {
+	case code == "23505": // unique_violation
+		return ErrConflict
+	case code == "23503": // foreign_key_violation : joueur ou mot inconnu
+		return ErrNotFound
+	case strings.HasPrefix(code, "22"), strings.HasPrefix(code, "23"): // données ou contraintes
+		return ErrInvalid
+	case strings.HasPrefix(code, "08"), strings.HasPrefix(code, "53"), strings.HasPrefix(code, "57P"),
+		code == "57014":
+		// connexion, ressources insuffisantes, arrêt du serveur, requête annulée (délai)
+		return ErrUnavailable
+	}
+	return nil
+}
+
+// validateName vérifie le nom d'un nouveau joueur
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return invalid("nom de joueur vide")
+	}
+	return nil
+}
+
+// maxTeamLength longueur maximale d'un nom d'équipe
+const maxTeamLength = 32
+
+// ValidateTeam vérifie un nom d'équipe ("" : aucune équipe)
+func ValidateTeam(team string) error {
+	if team != strings.TrimSpace(team) || len([]rune(team)) > maxTeamLength {
+		return invalid("nom d'équipe invalide: %q (%d caractères au plus, sans espaces autour)", team, maxTeamLength)
+	}
+	return nil
+}
+
+// validateXP vérifie l'XP et le niveau avant une mise à jour
+func validateXP(xp, level int) error {
+	if xp < 0 || level < 1 {
+		return invalid("XP ou niveau invalide: xp=%d, niveau=%d", xp, level)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+func TestClassifyDriverErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"pq unique", &pq.Error{Code: "23505"}, ErrConflict},
+		{"pgx unique", &pgconn.PgError{Code: "23505"}, ErrConflict},
+		{"pq clé étrangère", &pq.Error{Code: "23503"}, ErrNotFound},
+		{"pq check", &pq.Error{Code: "23514"}, ErrInvalid},
+		{"pq connexion", &pq.Error{Code: "08006"}, ErrUnavailable},
+		{"pq requête annulée", &pq.Error{Code: "57014"}, ErrUnavailable},
+		{"délai dépassé", context.DeadlineExceeded, ErrUnavailable},
+		{"gorm", gorm.ErrRecordNotFound, ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		err := wrapErr(tt.err, "opération")
+		if !errors.Is(err, tt.want) || !errors.Is(err, tt.err) {
+			t.Errorf("%s: %v devrait être %v et garder sa cause", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestMemoryStoreSentinels(t *testing.T) {
+	ctx := t.Context()
+	s := NewMemoryStore()
+	if _, err := s.Create(ctx, "Alice"); err != nil {
+		t.Fatalf("création: %v", err)
+	}
+
+	if _, err := s.Create(ctx, "Alice"); !errors.Is(err, ErrConflict) {
+		t.Errorf("doublon: ErrConflict attendu, obtenu %v", err)
+	}
+	if _, err := s.Create(ctx, "  "); !errors.Is(err, ErrInvalid) {
+		t.Errorf("nom vide: ErrInvalid attendu, obtenu %v", err)
+	}
+	if _, err := s.Get(ctx, "inconnu"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("joueur inconnu: ErrNotFound attendu, obtenu %v", err)
+	}
+	if err := s.UpdateXP(ctx, "p1", -5, 1); !errors.Is(err, ErrInvalid) {
+		t.Errorf("XP négative: ErrInvalid attendu, obtenu %v", err)
+	}
+}
@@ -0,0 +1,731 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+	"github.com/google/uuid"
+)
+
+// Fichiers du répertoire d'un FileStore
+const (
+	fileStoreLog      = "wal.ndjson"
+	fileStoreSnapshot = "snapshot.json"
+	fileStoreVersion  = 1
+)
+
+// Opérations du journal
+const (
+	opPlayerCreated       = "player_created"
+	opPlayerDeleted       = "player_deleted"
+	opXPUpdated           = "xp_updated"
+	opTeamSet             = "team_set"
+	opCaptureAdded        = "capture_added"
+	opWordsSeeded         = "words_seeded"
+	opWordsSynced         = "words_synced"
+	opTokenSaved          = "token_saved"
+	opTokenRevoked        = "token_revoked"
+	opPlayerTokensRevoked = "player_tokens_revoked"
+	opWebhookCreated      = "webhook_created"
+	opWebhookDeleted      = "webhook_deleted"
+	opPlayerRestored      = "player_restored"
+	opCaptureRestored     = "capture_restored"
+	opCatalogRestored     = "catalog_restored"
+	opDeliverySaved       = "delivery_saved"
+)
+
+// walRecord une ligne du journal : une opération d'écriture
+type walRecord struct {
+	Seq      uint64                `json:"seq"`
+	Op       string                `json:"op"`
+	ID       string                `json:"id,omitempty"` // joueur, jeton ou abonnement visé
+	Name     string                `json:"name,omitempty"`
+	Word     string                `json:"word,omitempty"`
+	XP       int                   `json:"xp,omitempty"`
+	Level    int                   `json:"level,omitempty"`
+	Team     string                `json:"team,omitempty"`
+	Words    []core.Word           `json:"words,omitempty"`
+	Catalog  []CatalogWord         `json:"catalog,omitempty"`
+	Versions []CatalogVersion      `json:"versions,omitempty"`
+	Token    *auth.Token           `json:"token,omitempty"`
+	Webhook  *webhook.Subscription `json:"webhook,omitempty"`
+	Delivery *webhook.Delivery     `json:"delivery,omitempty"`
+	At       time.Time             `json:"at"`
+}
+
+// fileSnapshot contenu de snapshot.json : l'état après l'enregistrement Seq
+type fileSnapshot struct {
+	Version int         `json:"version"`
+	Seq     uint64      `json:"seq"`
+	SavedAt time.Time   `json:"savedAt"`
+	State   memoryState `json:"state"`
+}
+
+// FileStore store persistant pour un déploiement à un seul nœud, sans
+// dépendance externe. L'état vit dans un MemoryStore ; chaque écriture est
+// validée, ajoutée au journal (wal.ndjson, fsync) puis appliquée. Au démarrage,
+// le snapshot puis le journal sont rejoués. Compact remplace le journal par un
+// snapshot écrit dans un fichier temporaire puis renommé. Chaque état d'une
+// livraison webhook est journalisé : les livraisons en attente reprennent au
+// redémarrage et les lettres mortes restent consultables.
+type FileStore struct {
+	mem *MemoryStore
+	bus *core.EventBus // nil : aucune publication
+
+	mu      sync.Mutex // sérialise les écritures : validation, journal puis application
+	dir     string
+	wal     *os.File
+	size    int64  // taille du journal valide
+	seq     uint64 // dernier enregistrement journalisé
+	pending int    // enregistrements depuis le dernier snapshot
+}
+
+// FileStore implémente l'interface complète et les webhooks
+var (
+	_ Store         = (*FileStore)(nil)
+	_ webhook.Store = (*FileStore)(nil)
+)
+
+// NewFileStore ouvre (ou crée) le store du répertoire dir et rejoue son état
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("erreur création répertoire %s: %w", dir, err)
+	}
+
+	s := &FileStore{mem: NewMemoryStore(), dir: dir}
+	if err := s.loadSnapshot(); err != nil {
+		return nil, err
+	}
+	replayed, err := s.replay()
+	if err != nil {
+		return nil, err
+	}
+
+	wal, err := os.OpenFile(filepath.Join(dir, fileStoreLog), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("erreur ouverture journal: %w", err)
+	}
+	s.wal = wal
+
+	log.Printf("[filestore] %s: état restauré (seq %d, %d opérations rejouées)", dir, s.seq, replayed)
+	return s, nil
+}
+
+// Close compacte le journal puis le ferme
+func (s *FileStore) Close() error {
+	err := s.Compact()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if closeErr := s.wal.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("erreur fermeture journal: %w", closeErr)
+	}
+	return err
+}
+
+// SetEventBus définit le bus sur lequel le store publie ses événements (le
+// MemoryStore interne n'en a pas : la publication se fait hors du verrou du journal)
+func (s *FileStore) SetEventBus(bus *core.EventBus) {
+	s.bus = bus
+}
+
+// StartCompaction compacte le journal toutes les interval jusqu'à
+// l'annulation du contexte (sans effet si rien n'a été écrit)
+func (s *FileStore) StartCompaction(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.Compact(); err != nil {
+					log.Printf("[filestore] erreur compaction: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Compact écrit l'état courant dans snapshot.json et vide le journal. Un arrêt
+// entre les deux étapes est sans danger : le rejeu ignore les enregistrements
+// déjà couverts par le snapshot.
+func (s *FileStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending == 0 {
+		return nil
+	}
+
+	snapshot := fileSnapshot{Version: fileStoreVersion, Seq: s.seq, SavedAt: time.Now().UTC(), State: s.mem.state()}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("erreur encodage snapshot: %w", err)
+	}
+	if err := writeFileAtomic(filepath.Join(s.dir, fileStoreSnapshot), data); err != nil {
+		return err
+	}
+
+	if err := s.wal.Truncate(0); err != nil {
+		return fmt.Errorf("erreur troncature journal: %w", err)
+	}
+	if err := s.wal.Sync(); err != nil {
+		return fmt.Errorf("erreur fsync journal: %w", err)
+	}
+	s.size = 0
+	s.pending = 0
+
+	log.Printf("[filestore] compaction: seq %d, %d joueurs", snapshot.Seq, len(snapshot.State.Players))
+	return nil
+}
+
+// === RESTAURATION ===
+
+// loadSnapshot charge snapshot.json s'il existe
+func (s *FileStore) loadSnapshot() error {
+	data, err := os.ReadFile(filepath.Join(s.dir, fileStoreSnapshot))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("erreur lecture snapshot: %w", err)
+	}
+
+	var snapshot fileSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("erreur parsing snapshot: %w", err)
+	}
+	if snapshot.Version != fileStoreVersion {
+		return fmt.Errorf("version de snapshot non supportée: %d", snapshot.Version)
+	}
+	if err := s.mem.load(snapshot.State); err != nil {
+		return fmt.Errorf("erreur chargement snapshot: %w", err)
+	}
+	s.seq = snapshot.Seq
+	return nil
+}
+
+// replay rejoue le journal après le snapshot. Une dernière ligne incomplète
+// (arrêt pendant une écriture) est tronquée ; une ligne invalide au milieu
+// du journal est une erreur.
+func (s *FileStore) replay() (int, error) {
+	path := filepath.Join(s.dir, fileStoreLog)
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("erreur ouverture journal: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	replayed := 0
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(data) > 0 {
+				log.Printf("[filestore] journal: ligne %d incomplète ignorée", line)
+				return replayed, s.truncateLog(path)
+			}
+			return replayed, nil
+		}
+		if err != nil {
+			return replayed, fmt.Errorf("erreur lecture journal: %w", err)
+		}
+
+		var record walRecord
+		if err := json.Unmarshal(bytes.TrimSpace(data), &record); err != nil {
+			if _, peekErr := reader.Peek(1); errors.Is(peekErr, io.EOF) {
+				log.Printf("[filestore] journal: dernière ligne %d illisible ignorée", line)
+				return replayed, s.truncateLog(path)
+			}
+			return replayed, fmt.Errorf("journal corrompu ligne %d: %w", line, err)
+		}
+
+		s.size += int64(len(data))
+		if record.Seq <= s.seq {
+			continue // déjà dans le snapshot
+		}
+		if err := s.apply(context.Background(), record); err != nil {
+			return replayed, fmt.Errorf("erreur rejeu ligne %d (%s): %w", line, record.Op, err)
+		}
+		s.seq = record.Seq
+		s.pending++
+		replayed++
+	}
+}
+
+// truncateLog ramène le journal à sa partie valide
+func (s *FileStore) truncateLog(path string) error {
+	if err := os.Truncate(path, s.size); err != nil {
+		return fmt.Errorf("erreur troncature journal: %w", err)
+	}
+	return nil
+}
+
+// apply applique un enregistrement au MemoryStore
+func (s *FileStore) apply(ctx context.Context, record walRecord) error {
+	switch record.Op {
+	case opPlayerCreated:
+		player, err := s.mem.create(record.Name)
+		if err != nil {
+			return err
+		}
+		if player.ID != record.ID {
+			return fmt.Errorf("ID %s attendu, %s obtenu", record.ID, player.ID)
+		}
+		return nil
+	case opPlayerDeleted:
+		return s.mem.Delete(ctx, record.ID)
+	case opXPUpdated:
+		return s.mem.UpdateXP(ctx, record.ID, record.XP, record.Level)
+	case opTeamSet:
+		return s.mem.SetTeam(ctx, record.ID, record.Team)
+	case opCaptureAdded:
+		_, err := s.mem.addAt(record.ID, record.Word, record.At)
+		return err
+	case opWordsSeeded:
+		return s.mem.Seed(ctx, record.Words)
+	case opWordsSynced:
+		_, err := s.mem.syncWords(record.Words, true, record.At)
+		return err
+	case opTokenSaved:
+		return s.mem.SaveToken(ctx, record.Token)
+	case opTokenRevoked:
+		return s.mem.RevokeToken(ctx, record.ID, record.At)
+	case opPlayerTokensRevoked:
+		return s.mem.RevokePlayerTokens(ctx, record.ID, record.At)
+	case opWebhookCreated:
+		return s.mem.CreateWebhook(ctx, record.Webhook)
+	case opWebhookDeleted:
+		return s.mem.DeleteWebhook(ctx, record.ID)
+	case opPlayerRestored:
+		id, err := s.mem.RestorePlayer(ctx, core.Player{ID: record.ID, Name: record.Name, XP: record.XP, Level: record.Level, Team: record.Team})
+		if err != nil {
+			return err
+		}
+		if id != record.ID {
+			return fmt.Errorf("ID %s attendu, %s obtenu", record.ID, id)
+		}
+		return nil
+	case opCaptureRestored:
+		return s.mem.RestoreCapture(ctx, record.ID, Capture{WordID: record.Word, CapturedAt: record.At})
+	case opCatalogRestored:
+		return s.mem.RestoreCatalog(ctx, record.Catalog, record.Versions)
+	case opDeliverySaved:
+		return s.mem.SaveDelivery(ctx, record.Delivery)
+	default:
+		return fmt.Errorf("opération inconnue: %s", record.Op)
+	}
+}
+
+// write journalise l'enregistrement (fsync) puis l'applique ; verrou requis.
+// Rien n'est écrit si l'appelant a abandonné pendant l'attente du verrou.
+func (s *FileStore) write(ctx context.Context, record walRecord) error {
+	if err := ctx.Err(); err != nil {
+		return wrapErr(err, "écriture abandonnée")
+	}
+	record.Seq = s.seq + 1
+	if record.At.IsZero() {
+		record.At = time.Now().UTC()
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("erreur encodage journal: %w", err)
+	}
+	data = append(data, '\n')
+
+	if _, err := s.wal.Write(data); err != nil {
+		// Retirer une éventuelle écriture partielle
+		s.wal.Truncate(s.size)
+		return &storeError{msg: "erreur écriture journal", kind: ErrUnavailable, err: err}
+	}
+	if err := s.wal.Sync(); err != nil {
+		s.wal.Truncate(s.size)
+		return &storeError{msg: "erreur fsync journal", kind: ErrUnavailable, err: err}
+	}
+	s.size += int64(len(data))
+	s.seq = record.Seq
+	s.pending++
+
+	return s.apply(ctx, record)
+}
+
+// writeFileAtomic écrit data dans path via un fichier temporaire synchronisé puis renommé
+func writeFileAtomic(path string, data []byte) error {
+	tempFile := path + ".tmp"
+
+	file, err := os.OpenFile(tempFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("erreur écriture fichier temporaire: %w", err)
+	}
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile)
+		return fmt.Errorf("erreur écriture fichier temporaire: %w", err)
+	}
+
+	if err := os.Rename(tempFile, path); err != nil {
+		os.Remove(tempFile)
+		return fmt.Errorf("erreur rename fichier: %w", err)
+	}
+
+	// Rendre le rename durable
+	if dir, err := os.Open(filepath.Dir(path)); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+	return nil
+}
+
+// === PLAYER REPOSITORY ===
+
+// Create crée un nouveau joueur (nom unique)
+func (s *FileStore) Create(ctx context.Context, name string) (*core.Player, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	player, err := s.create(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Publier hors du verrou (les abonnés synchrones peuvent relire le store)
+	s.bus.Publish(core.PlayerCreated{PlayerID: player.ID, Name: player.Name, At: time.Now().UTC()})
+	return player, nil
+}
+
+// create journalise l'inscription sous le verrou, sans publier
+func (s *FileStore) create(ctx context.Context, name string) (*core.Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mem.nameTaken(name) {
+		return nil, conflict("nom déjà pris: %s", name)
+	}
+	id := s.mem.nextID()
+	if err := s.write(ctx, walRecord{Op: opPlayerCreated, ID: id, Name: name}); err != nil {
+		return nil, err
+	}
+	return s.mem.Get(ctx, id)
+}
+
+// Get récupère une copie du joueur (inventaire compris)
+func (s *FileStore) Get(ctx context.Context, id string) (*core.Player, error) {
+	return s.mem.Get(ctx, id)
+}
+
+// List retourne les joueurs par XP décroissant (tous si limit <= 0)
+func (s *FileStore) List(ctx context.Context, limit int) ([]core.Player, error) {
+	return s.mem.List(ctx, limit)
+}
+
+// UpdateXP met à jour l'XP et le niveau d'un joueur
+func (s *FileStore) UpdateXP(ctx context.Context, id string, newXP int, newLevel int) error {
+	if err := validateXP(newXP, newLevel); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.mem.hasPlayer(id) {
+		return notFound("joueur non trouvé: %s", id)
+	}
+	return s.write(ctx, walRecord{Op: opXPUpdated, ID: id, XP: newXP, Level: newLevel})
+}
+
+// AddXP ajoute delta à l'XP d'un joueur (plancher à 0) et recalcule son
+// niveau ; le journal reçoit la valeur obtenue, lue sous le même verrou que
+// les captures
+func (s *FileStore) AddXP(ctx context.Context, id string, delta int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, err := s.mem.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	xp := max(player.XP+delta, 0)
+	if err := s.write(ctx, walRecord{Op: opXPUpdated, ID: id, XP: xp, Level: core.LevelFromXP(xp)}); err != nil {
+		return 0, err
+	}
+	return xp - player.XP, nil
+}
+
+// SetTeam change l'équipe d'un joueur ("" : aucune)
+func (s *FileStore) SetTeam(ctx context.Context, id string, team string) error {
+	if err := ValidateTeam(team); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.mem.hasPlayer(id) {
+		return notFound("joueur non trouvé: %s", id)
+	}
+	return s.write(ctx, walRecord{Op: opTeamSet, ID: id, Team: team})
+}
+
+// Delete supprime un joueur, ses captures et ses jetons
+func (s *FileStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.mem.hasPlayer(id) {
+		return notFound("joueur non trouvé: %s", id)
+	}
+	return s.write(ctx, walRecord{Op: opPlayerDeleted, ID: id})
+}
+
+// Leaderboard retourne une page du classement filtré
+func (s *FileStore) Leaderboard(ctx context.Context, q LeaderboardQuery) (*LeaderboardPage, error) {
+	return s.mem.Leaderboard(ctx, q)
+}
+
+// PlayerRank retourne la place d'un joueur et ses voisins dans le classement filtré
+func (s *FileStore) PlayerRank(ctx context.Context, id string, q LeaderboardQuery, neighbors int) (*PlayerRank, error) {
+	return s.mem.PlayerRank(ctx, id, q, neighbors)
+}
+
+// === WORD REPOSITORY ===
+
+// Seed ajoute les mots absents du catalogue (seuls ceux-ci sont journalisés)
+func (s *FileStore) Seed(ctx context.Context, words []core.Word) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	missing := s.mem.missingWords(words)
+	if len(missing) == 0 {
+		return nil
+	}
+	return s.write(ctx, walRecord{Op: opWordsSeeded, Words: missing})
+}
+
+// GetWord récupère un mot par ID
+func (s *FileStore) GetWord(ctx context.Context, id string) (*core.Word, error) {
+	return s.mem.GetWord(ctx, id)
+}
+
+// RandomByRarity tire un mot au hasard parmi ceux d'une rareté
+func (s *FileStore) RandomByRarity(ctx context.Context, rarity string) (*core.Word, error) {
+	return s.mem.RandomByRarity(ctx, rarity)
+}
+
+// === CAPTURE REPOSITORY ===
+
+// Add enregistre une capture et crédite les points du mot au joueur
+func (s *FileStore) Add(ctx context.Context, playerID, wordID string) error {
+	events, err := s.add(ctx, playerID, wordID)
+	if err != nil {
+		return err
+	}
+
+	// Publier hors du verrou (les abonnés synchrones peuvent relire le store)
+	s.bus.Publish(events...)
+	return nil
+}
+
+// add journalise la capture sous le verrou et retourne ses événements
+func (s *FileStore) add(ctx context.Context, playerID, wordID string) ([]core.DomainEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, err := s.mem.Get(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	word, err := s.mem.GetWord(ctx, wordID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.write(ctx, walRecord{Op: opCaptureAdded, ID: playerID, Word: wordID}); err != nil {
+		return nil, err
+	}
+	updated, err := s.mem.Get(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return core.CaptureEvents(*updated, *word, player.Level), nil
+}
+
+// ListByPlayer retourne les mots capturés par un joueur, dans l'ordre des captures
+func (s *FileStore) ListByPlayer(ctx context.Context, playerID string) ([]core.Word, error) {
+	return s.mem.ListByPlayer(ctx, playerID)
+}
+
+// === TOKEN REPOSITORY ===
+
+// SaveToken enregistre l'empreinte d'un jeton de joueur
+func (s *FileStore) SaveToken(ctx context.Context, t *auth.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.mem.GetToken(ctx, t.ID); err == nil {
+		return conflict("jeton déjà enregistré: %s", t.ID)
+	}
+	token := *t
+	return s.write(ctx, walRecord{Op: opTokenSaved, ID: t.ID, Token: &token})
+}
+
+// GetToken récupère un jeton par ID
+func (s *FileStore) GetToken(ctx context.Context, id string) (*auth.Token, error) {
+	return s.mem.GetToken(ctx, id)
+}
+
+// RevokeToken révoque un jeton (sans effet s'il l'est déjà)
+func (s *FileStore) RevokeToken(ctx context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, err := s.mem.GetToken(ctx, id)
+	if err != nil {
+		return err
+	}
+	if token.Revoked() {
+		return nil
+	}
+	return s.write(ctx, walRecord{Op: opTokenRevoked, ID: id, At: at})
+}
+
+// RevokePlayerTokens révoque tous les jetons actifs d'un joueur
+func (s *FileStore) RevokePlayerTokens(ctx context.Context, playerID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.write(ctx, walRecord{Op: opPlayerTokensRevoked, ID: playerID, At: at})
+}
+
+// === WEBHOOK REPOSITORY ===
+
+// CreateWebhook enregistre un abonnement webhook (ID et date attribués avant journalisation)
+func (s *FileStore) CreateWebhook(ctx context.Context, sub *webhook.Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sub.ID == "" {
+		sub.ID = uuid.New().String()
+	}
+	if sub.CreatedAt.IsZero() {
+		sub.CreatedAt = time.Now().UTC()
+	}
+	stored := *sub
+	stored.Events = append([]string(nil), sub.Events...)
+	return s.write(ctx, walRecord{Op: opWebhookCreated, ID: sub.ID, Webhook: &stored})
+}
+
+// GetWebhook récupère un abonnement par ID
+func (s *FileStore) GetWebhook(ctx context.Context, id string) (*webhook.Subscription, error) {
+	return s.mem.GetWebhook(ctx, id)
+}
+
+// ListWebhooks retourne tous les abonnements
+func (s *FileStore) ListWebhooks(ctx context.Context) ([]webhook.Subscription, error) {
+	return s.mem.ListWebhooks(ctx)
+}
+
+// DeleteWebhook supprime un abonnement et son journal de livraisons
+func (s *FileStore) DeleteWebhook(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.mem.GetWebhook(ctx, id); err != nil {
+		return err
+	}
+	return s.write(ctx, walRecord{Op: opWebhookDeleted, ID: id})
+}
+
+// SaveDelivery insère ou met à jour une livraison
+func (s *FileStore) SaveDelivery(ctx context.Context, d *webhook.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *d
+	return s.write(ctx, walRecord{Op: opDeliverySaved, ID: d.ID, Delivery: &stored})
+}
+
+// ListDeliveries retourne les dernières livraisons d'un abonnement
+func (s *FileStore) ListDeliveries(ctx context.Context, webhookID, status string, limit int) ([]webhook.Delivery, error) {
+	return s.mem.ListDeliveries(ctx, webhookID, status, limit)
+}
+
+// === SAUVEGARDE ===
+
+// ListWords retourne le catalogue trié par ID
+func (s *FileStore) ListWords(ctx context.Context) ([]core.Word, error) {
+	return s.mem.ListWords(ctx)
+}
+
+// ListCaptures retourne les captures datées d'un joueur
+func (s *FileStore) ListCaptures(ctx context.Context, playerID string) ([]Capture, error) {
+	return s.mem.ListCaptures(ctx, playerID)
+}
+
+// ListTokens retourne les jetons d'un joueur par date de création
+func (s *FileStore) ListTokens(ctx context.Context, playerID string) ([]auth.Token, error) {
+	return s.mem.ListTokens(ctx, playerID)
+}
+
+// RestorePlayer insère un joueur tel quel ; l'ID est conservé s'il est libre
+func (s *FileStore) RestorePlayer(ctx context.Context, p core.Player) (string, error) {
+	if err := validateName(p.Name); err != nil {
+		return "", err
+	}
+	if err := validateXP(p.XP, p.Level); err != nil {
+		return "", err
+	}
+	if err := ValidateTeam(p.Team); err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mem.nameTaken(p.Name) {
+		return "", conflict("nom déjà pris: %s", p.Name)
+	}
+	id := s.mem.restoreID(p.ID)
+	record := walRecord{Op: opPlayerRestored, ID: id, Name: p.Name, XP: p.XP, Level: p.Level, Team: p.Team}
+	if err := s.write(ctx, record); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// RestoreCapture insère une capture datée sans créditer d'XP
+func (s *FileStore) RestoreCapture(ctx context.Context, playerID string, capture Capture) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.mem.hasPlayer(playerID) {
+		return notFound("joueur non trouvé: %s", playerID)
+	}
+	if _, err := s.mem.GetWord(ctx, capture.WordID); err != nil {
+		return err
+	}
+	return s.write(ctx, walRecord{Op: opCaptureRestored, ID: playerID, Word: capture.WordID, At: capture.CapturedAt})
+}
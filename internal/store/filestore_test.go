@@ -0,0 +1,213 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+)
+
+// fillFileStore écrit un peu de tout dans le store
+func fillFileStore(t *testing.T, s *FileStore) string {
+	t.Helper()
+	ctx := t.Context()
+	if err := s.Seed(ctx, []core.Word{
+		{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5},
+		{ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 50},
+	}); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	alice, err := s.Create(ctx, "Alice")
+	if err != nil {
+		t.Fatalf("création: %v", err)
+	}
+	bob, _ := s.Create(ctx, "Bob")
+	s.Add(ctx, alice.ID, "l_1")
+	s.Add(ctx, alice.ID, "c_1")
+	s.SetTeam(ctx, alice.ID, "rouge")
+	s.Delete(ctx, bob.ID)
+	s.SaveToken(ctx, &auth.Token{ID: "t1", PlayerID: alice.ID, Hash: "h", CreatedAt: time.Now().UTC()})
+	sub := &webhook.Subscription{URL: "http://example.test", Events: []string{"player.created"}}
+	s.CreateWebhook(ctx, sub)
+	dead := &webhook.Delivery{ID: "d1", WebhookID: sub.ID, Event: "player.created", Status: webhook.StatusPending}
+	s.SaveDelivery(ctx, dead)
+	dead.Status, dead.Attempts = webhook.StatusDead, 5
+	s.SaveDelivery(ctx, dead)
+	s.SaveDelivery(ctx, &webhook.Delivery{ID: "d2", WebhookID: sub.ID, Event: "player.created", Status: webhook.StatusPending})
+	return alice.ID
+}
+
+// checkFileStore vérifie l'état écrit par fillFileStore
+func checkFileStore(t *testing.T, s *FileStore, aliceID string) {
+	t.Helper()
+	ctx := t.Context()
+	alice, err := s.Get(ctx, aliceID)
+	if err != nil {
+		t.Fatalf("Alice absente après réouverture: %v", err)
+	}
+	if alice.XP != 55 || alice.Team != "rouge" || alice.Inventory["l_1"] != 1 {
+		t.Errorf("Alice mal restaurée: %+v", alice)
+	}
+	if players, _ := s.List(ctx, 0); len(players) != 1 {
+		t.Errorf("1 joueur attendu, obtenu %d", len(players))
+	}
+	if page, _ := s.Leaderboard(ctx, LeaderboardQuery{By: RankByLegendary}); page.Players[0].Score != 1 {
+		t.Errorf("index de classement non reconstruit: %+v", page.Players)
+	}
+	if _, err := s.GetToken(ctx, "t1"); err != nil {
+		t.Errorf("jeton perdu: %v", err)
+	}
+	subs, _ := s.ListWebhooks(ctx)
+	if len(subs) != 1 {
+		t.Fatalf("webhook perdu: %+v", subs)
+	}
+	// Lettre morte et livraison en attente survivent au redémarrage
+	deliveries, _ := s.ListDeliveries(ctx, subs[0].ID, "", 0)
+	if len(deliveries) != 2 || deliveries[0].ID != "d2" || deliveries[1].Status != webhook.StatusDead || deliveries[1].Attempts != 5 {
+		t.Errorf("livraisons mal restaurées: %+v", deliveries)
+	}
+
+	// Les IDs continuent après le dernier joueur créé, même supprimé
+	carol, _ := s.Create(ctx, "Carol")
+	if carol.ID != "p3" {
+		t.Errorf("ID p3 attendu, obtenu %s", carol.ID)
+	}
+}
+
+func TestFileStoreReplaysLog(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("ouverture: %v", err)
+	}
+	aliceID := fillFileStore(t, s)
+	// Pas de Close : simule un arrêt brutal, seul le journal existe
+	s.wal.Close()
+
+	reopened, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("réouverture: %v", err)
+	}
+	defer reopened.Close()
+	checkFileStore(t, reopened, aliceID)
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	dir := t.TempDir()
+	s, _ := NewFileStore(dir)
+	aliceID := fillFileStore(t, s)
+	if err := s.Close(); err != nil {
+		t.Fatalf("fermeture: %v", err)
+	}
+
+	if info, err := os.Stat(filepath.Join(dir, fileStoreLog)); err != nil || info.Size() != 0 {
+		t.Errorf("journal vide attendu après compaction: %v", err)
+	}
+
+	reopened, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("réouverture: %v", err)
+	}
+	defer reopened.Close()
+	checkFileStore(t, reopened, aliceID)
+}
+
+func TestFileStoreIgnoresTornLastLine(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	s, _ := NewFileStore(dir)
+	alice, _ := s.Create(ctx, "Alice")
+	s.wal.Close()
+
+	// Arrêt au milieu d'une écriture
+	path := filepath.Join(dir, fileStoreLog)
+	file, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	file.WriteString(`{"seq":2,"op":"player_cre`)
+	file.Close()
+
+	reopened, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("réouverture: %v", err)
+	}
+	defer reopened.Close()
+	if _, err := reopened.Get(ctx, alice.ID); err != nil {
+		t.Errorf("Alice perdue: %v", err)
+	}
+
+	// Le journal tronqué accepte de nouvelles écritures
+	if _, err := reopened.Create(ctx, "Bob"); err != nil {
+		t.Fatalf("création après troncature: %v", err)
+	}
+	reopened.wal.Close()
+	again, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("seconde réouverture: %v", err)
+	}
+	defer again.Close()
+	if players, _ := again.List(ctx, 0); len(players) != 2 {
+		t.Errorf("2 joueurs attendus, obtenu %d", len(players))
+	}
+}
+
+func TestFileStoreSkipsAbandonedWrites(t *testing.T) {
+	s, _ := NewFileStore(t.TempDir())
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if _, err := s.Create(ctx, "Alice"); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("contexte annulé: ErrUnavailable attendu, obtenu %v", err)
+	}
+	if s.seq != 0 || s.size != 0 {
+		t.Errorf("rien ne doit être journalisé: seq %d, %d octets", s.seq, s.size)
+	}
+}
+
+func TestStoresPublishOutsideLocks(t *testing.T) {
+	file, _ := NewFileStore(t.TempDir())
+	defer file.Close()
+
+	for name, s := range map[string]interface {
+		Store
+		SetEventBus(*core.EventBus)
+	}{"memory": NewMemoryStore(), "file": file} {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			s.Seed(ctx, []core.Word{{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}})
+
+			// Un abonné synchrone relit et modifie le store pendant la publication
+			bus := core.NewEventBus()
+			bus.Subscribe(func(e core.DomainEvent) {
+				if created, ok := e.(core.PlayerCreated); ok {
+					s.SetTeam(ctx, created.PlayerID, "rouge")
+				}
+				if captured, ok := e.(core.WordCaptured); ok {
+					s.Get(ctx, captured.PlayerID)
+				}
+			})
+			s.SetEventBus(bus)
+
+			done := make(chan *core.Player)
+			go func() {
+				player, _ := s.Create(ctx, "Alice")
+				s.Add(ctx, player.ID, "c_1")
+				player, _ = s.Get(ctx, player.ID)
+				done <- player
+			}()
+			select {
+			case player := <-done:
+				if player.Team != "rouge" || player.XP != 5 {
+					t.Errorf("abonné sans effet: %+v", player)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("publication sous verrou: le store est bloqué")
+			}
+		})
+	}
+}
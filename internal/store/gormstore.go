@@ -1,19 +1,28 @@
 package store
 
 import (
-	"fmt"
+	"context"
+	"database/sql"
+	"errors"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/SamG1008/wordmon-go/internal/auth"
 	"github.com/SamG1008/wordmon-go/internal/core"
 	"github.com/SamG1008/wordmon-go/internal/models"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
 // GORMStore implémente la persistance avec GORM
 type GORMStore struct {
-	db *gorm.DB
+	db       *gorm.DB
+	bus      *core.EventBus
+	timeouts Timeouts
 }
 
 // NewGORMStore crée un nouveau store GORM
@@ -25,29 +34,27 @@ func NewGORMStore(databaseURL string) (*GORMStore, error) {
 
 	db, err := gorm.Open(postgres.Open(databaseURL), config)
 	if err != nil {
-		return nil, fmt.Errorf("erreur connexion GORM: %w", err)
+		return nil, wrapErr(err, "erreur connexion GORM")
 	}
 
 	// Test de la connexion
 	sqlDB, err := db.DB()
 	if err != nil {
-		return nil, fmt.Errorf("erreur récupération DB: %w", err)
+		return nil, wrapErr(err, "erreur récupération DB")
 	}
 
 	if err := sqlDB.Ping(); err != nil {
-		return nil, fmt.Errorf("erreur ping DB: %w", err)
+		return nil, wrapErr(err, "erreur ping DB")
 	}
 
 	log.Printf("[db] Connected to Postgres via GORM (wordmon)")
 
-	// Auto-migration des modèles
-	err = db.AutoMigrate(&models.Player{}, &models.Word{}, &models.Capture{})
-	if err != nil {
-		return nil, fmt.Errorf("erreur auto-migration: %w", err)
+	// Même schéma que SQLStore : les migrations SQL, pas d'AutoMigrate
+	if err := migrateSchema(sqlDB); err != nil {
+		sqlDB.Close()
+		return nil, err
 	}
 
-	log.Printf("[migrate] GORM auto-migration completed")
-
 	return &GORMStore{db: db}, nil
 }
 
@@ -60,20 +67,50 @@ func (s *GORMStore) Close() error {
 	return sqlDB.Close()
 }
 
+// DB retourne la connexion SQL sous-jacente (partagée avec d'autres services)
+func (s *GORMStore) DB() (*sql.DB, error) {
+	return s.db.DB()
+}
+
+// SetEventBus définit le bus sur lequel le store publie ses événements
+func (s *GORMStore) SetEventBus(bus *core.EventBus) {
+	s.bus = bus
+}
+
+// SetTimeouts définit les délais des lectures et des écritures
+func (s *GORMStore) SetTimeouts(timeouts Timeouts) {
+	s.timeouts = timeouts
+}
+
+// session retourne une session GORM liée à ctx, bornée au délai timeout
+func (s *GORMStore) session(ctx context.Context, timeout func(context.Context) (context.Context, context.CancelFunc)) (*gorm.DB, context.CancelFunc) {
+	ctx, cancel := timeout(ctx)
+	return s.db.WithContext(ctx), cancel
+}
+
 // === PLAYER REPOSITORY ===
 
 // Create crée un nouveau joueur
-func (s *GORMStore) Create(name string) (*core.Player, error) {
+func (s *GORMStore) Create(ctx context.Context, name string) (*core.Player, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
 	player := &models.Player{
 		Name:  name,
 		XP:    0,
 		Level: 1,
 	}
 
-	if err := s.db.Create(player).Error; err != nil {
-		return nil, fmt.Errorf("erreur création joueur: %w", err)
+	if err := db.Create(player).Error; err != nil {
+		return nil, wrapErr(err, "erreur création joueur")
 	}
 
+	s.bus.Publish(core.PlayerCreated{PlayerID: player.ID, Name: player.Name, At: time.Now().UTC()})
+
 	return &core.Player{
 		ID:    player.ID,
 		Name:  player.Name,
@@ -83,35 +120,57 @@ func (s *GORMStore) Create(name string) (*core.Player, error) {
 }
 
 // Get récupère un joueur par ID
-func (s *GORMStore) Get(id string) (*core.Player, error) {
+func (s *GORMStore) Get(ctx context.Context, id string) (*core.Player, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
 	var player models.Player
 
-	if err := s.db.First(&player, "id = ?", id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("joueur introuvable: %s", id)
+	if err := db.First(&player, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notFound("joueur introuvable: %s", id)
 		}
-		return nil, fmt.Errorf("erreur récupération joueur: %w", err)
+		return nil, wrapErr(err, "erreur récupération joueur")
+	}
+
+	// Récupération de l'inventaire (captures)
+	var rows []struct {
+		WordID string
+		Count  int
+	}
+	if err := db.Model(&models.Capture{}).Select("word_id, COUNT(*) AS count").
+		Where("player_id = ?", id).Group("word_id").Scan(&rows).Error; err != nil {
+		return nil, wrapErr(err, "erreur récupération inventaire")
+	}
+	inventory := make(map[string]int, len(rows))
+	for _, row := range rows {
+		inventory[row.WordID] = row.Count
 	}
 
 	return &core.Player{
-		ID:    player.ID,
-		Name:  player.Name,
-		XP:    player.XP,
-		Level: player.Level,
+		ID:        player.ID,
+		Name:      player.Name,
+		XP:        player.XP,
+		Level:     player.Level,
+		Team:      player.Team,
+		Inventory: inventory,
 	}, nil
 }
 
 // List récupère une liste de joueurs
-func (s *GORMStore) List(limit int) ([]core.Player, error) {
+func (s *GORMStore) List(ctx context.Context, limit int) ([]core.Player, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
 	var players []models.Player
 
-	query := s.db.Order("xp DESC")
+	query := db.Order("xp DESC, id")
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
 
 	if err := query.Find(&players).Error; err != nil {
-		return nil, fmt.Errorf("erreur liste joueurs: %w", err)
+		return nil, wrapErr(err, "erreur liste joueurs")
 	}
 
 	result := make([]core.Player, len(players))
@@ -121,37 +180,138 @@ func (s *GORMStore) List(limit int) ([]core.Player, error) {
 			Name:  p.Name,
 			XP:    p.XP,
 			Level: p.Level,
+			Team:  p.Team,
 		}
 	}
 
 	return result, nil
 }
 
+// SetTeam change l'équipe d'un joueur ("" : aucune)
+func (s *GORMStore) SetTeam(ctx context.Context, id string, team string) error {
+	if err := ValidateTeam(team); err != nil {
+		return err
+	}
+
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	result := db.Model(&models.Player{}).Where("id = ?", id).Update("team", team)
+	if result.Error != nil {
+		return wrapErr(result.Error, "erreur changement d'équipe")
+	}
+	if result.RowsAffected == 0 {
+		return notFound("joueur introuvable: %s", id)
+	}
+	return nil
+}
+
+// Leaderboard retourne une page du classement filtré (requête SQL partagée
+// avec SQLStore : GORM n'exprime pas les fonctions de fenêtre)
+func (s *GORMStore) Leaderboard(ctx context.Context, q LeaderboardQuery) (*LeaderboardPage, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	db, err := s.DB()
+	if err != nil {
+		return nil, wrapErr(err, "erreur connexion DB")
+	}
+	return sqlLeaderboard(ctx, db, q)
+}
+
+// PlayerRank retourne la place d'un joueur et ses voisins dans le classement filtré
+func (s *GORMStore) PlayerRank(ctx context.Context, id string, q LeaderboardQuery, neighbors int) (*PlayerRank, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	db, err := s.DB()
+	if err != nil {
+		return nil, wrapErr(err, "erreur connexion DB")
+	}
+	return sqlPlayerRank(ctx, db, id, q, neighbors)
+}
+
 // UpdateXP met à jour l'XP et le niveau d'un joueur
-func (s *GORMStore) UpdateXP(id string, newXP int, newLevel int) error {
-	result := s.db.Model(&models.Player{}).Where("id = ?", id).Updates(map[string]interface{}{
+func (s *GORMStore) UpdateXP(ctx context.Context, id string, newXP int, newLevel int) error {
+	if err := validateXP(newXP, newLevel); err != nil {
+		return err
+	}
+
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	result := db.Model(&models.Player{}).Where("id = ?", id).Updates(map[string]interface{}{
 		"xp":    newXP,
 		"level": newLevel,
 	})
 
 	if result.Error != nil {
-		return fmt.Errorf("erreur mise à jour XP: %w", result.Error)
+		return wrapErr(result.Error, "erreur mise à jour XP")
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("joueur introuvable pour mise à jour XP: %s", id)
+		return notFound("joueur introuvable pour mise à jour XP: %s", id)
 	}
 
 	return nil
 }
 
+// AddXP ajoute delta à l'XP d'un joueur (plancher à 0) et recalcule son
+// niveau, la ligne du joueur verrouillée jusqu'au commit
+func (s *GORMStore) AddXP(ctx context.Context, id string, delta int) (int, error) {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	var applied int
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var player models.Player
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&player, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return notFound("joueur introuvable: %s", id)
+			}
+			return wrapErr(err, "erreur récupération joueur")
+		}
+
+		xp := max(player.XP+delta, 0)
+		if err := tx.Model(&player).Updates(map[string]interface{}{
+			"xp":    xp,
+			"level": core.LevelFromXP(xp),
+		}).Error; err != nil {
+			return wrapErr(err, "erreur mise à jour XP")
+		}
+		applied = xp - player.XP
+		return nil
+	})
+	return applied, err
+}
+
+// Delete supprime un joueur ; ses captures et jetons suivent (ON DELETE CASCADE)
+func (s *GORMStore) Delete(ctx context.Context, id string) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	result := db.Delete(&models.Player{}, "id = ?", id)
+	if result.Error != nil {
+		return wrapErr(result.Error, "erreur suppression joueur")
+	}
+	if result.RowsAffected == 0 {
+		return notFound("joueur introuvable: %s", id)
+	}
+	return nil
+}
+
 // === WORD REPOSITORY ===
 
 // Seed ajoute les mots en base
-func (s *GORMStore) Seed(words []core.Word) error {
+func (s *GORMStore) Seed(ctx context.Context, words []core.Word) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
 	// Vérifier si des mots existent déjà
 	var count int64
-	s.db.Model(&models.Word{}).Count(&count)
+	if err := db.Model(&models.Word{}).Count(&count).Error; err != nil {
+		return wrapErr(err, "erreur comptage mots")
+	}
 
 	if count > 0 {
 		log.Printf("[seed] %d words already in DB, skipping seed", count)
@@ -170,8 +330,8 @@ func (s *GORMStore) Seed(words []core.Word) error {
 	}
 
 	// Insertion en batch
-	if err := s.db.Create(&gormWords).Error; err != nil {
-		return fmt.Errorf("erreur seed words: %w", err)
+	if err := db.Create(&gormWords).Error; err != nil {
+		return wrapErr(err, "erreur seed words")
 	}
 
 	log.Printf("[seed] %d words loaded into DB", len(words))
@@ -179,14 +339,17 @@ func (s *GORMStore) Seed(words []core.Word) error {
 }
 
 // Get récupère un mot par ID
-func (s *GORMStore) GetWord(id string) (*core.Word, error) {
+func (s *GORMStore) GetWord(ctx context.Context, id string) (*core.Word, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
 	var word models.Word
 
-	if err := s.db.First(&word, "id = ?", id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("mot introuvable: %s", id)
+	if err := db.First(&word, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notFound("mot introuvable: %s", id)
 		}
-		return nil, fmt.Errorf("erreur récupération mot: %w", err)
+		return nil, wrapErr(err, "erreur récupération mot")
 	}
 
 	return &core.Word{
@@ -197,15 +360,18 @@ func (s *GORMStore) GetWord(id string) (*core.Word, error) {
 	}, nil
 }
 
-// RandomByRarity récupère un mot aléatoire selon la rareté
-func (s *GORMStore) RandomByRarity(rarity string) (*core.Word, error) {
+// RandomByRarity récupère un mot aléatoire selon la rareté, hors mots retirés
+func (s *GORMStore) RandomByRarity(ctx context.Context, rarity string) (*core.Word, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
 	var word models.Word
 
-	if err := s.db.Where("rarity = ?", rarity).Order("RANDOM()").First(&word).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("aucun mot trouvé pour rareté: %s", rarity)
+	if err := db.Where("rarity = ? AND retired_at IS NULL", rarity).Order("RANDOM()").First(&word).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notFound("aucun mot trouvé pour rareté: %s", rarity)
 		}
-		return nil, fmt.Errorf("erreur sélection mot aléatoire: %w", err)
+		return nil, wrapErr(err, "erreur sélection mot aléatoire")
 	}
 
 	return &core.Word{
@@ -216,21 +382,46 @@ func (s *GORMStore) RandomByRarity(rarity string) (*core.Word, error) {
 	}, nil
 }
 
+// SyncWords aligne le catalogue en base sur la config (requête SQL
+// partagée avec SQLStore)
+func (s *GORMStore) SyncWords(ctx context.Context, words []core.Word, apply bool) (*CatalogSync, error) {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	db, err := s.DB()
+	if err != nil {
+		return nil, wrapErr(err, "erreur connexion DB")
+	}
+	return sqlSyncWords(ctx, db, words, apply)
+}
+
 // === CAPTURE REPOSITORY ===
 
 // Add ajoute une capture (avec transaction)
-func (s *GORMStore) Add(playerId, wordId string) error {
-	return s.db.Transaction(func(tx *gorm.DB) error {
-		// Vérifier que le joueur existe
+func (s *GORMStore) Add(ctx context.Context, playerId, wordId string) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	var events []core.DomainEvent
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Vérifier que le joueur existe ; la ligne reste verrouillée jusqu'au
+		// commit, l'XP lue ne peut pas changer avant la mise à jour
 		var player models.Player
-		if err := tx.First(&player, "id = ?", playerId).Error; err != nil {
-			return fmt.Errorf("joueur introuvable: %s", playerId)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&player, "id = ?", playerId).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return notFound("joueur introuvable: %s", playerId)
+			}
+			return wrapErr(err, "erreur récupération joueur")
 		}
 
 		// Vérifier que le mot existe
 		var word models.Word
 		if err := tx.First(&word, "id = ?", wordId).Error; err != nil {
-			return fmt.Errorf("mot introuvable: %s", wordId)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return notFound("mot introuvable: %s", wordId)
+			}
+			return wrapErr(err, "erreur récupération mot")
 		}
 
 		// Créer la capture
@@ -240,31 +431,46 @@ func (s *GORMStore) Add(playerId, wordId string) error {
 		}
 
 		if err := tx.Create(capture).Error; err != nil {
-			return fmt.Errorf("erreur création capture: %w", err)
+			return wrapErr(err, "erreur création capture")
 		}
 
 		// Mettre à jour l'XP du joueur
+		previousLevel := player.Level
 		newXP := player.XP + word.Points
-		newLevel := (newXP / 100) + 1
+		newLevel := core.LevelFromXP(newXP)
 
 		if err := tx.Model(&player).Updates(map[string]interface{}{
 			"xp":    newXP,
 			"level": newLevel,
 		}).Error; err != nil {
-			return fmt.Errorf("erreur mise à jour XP: %w", err)
+			return wrapErr(err, "erreur mise à jour XP")
 		}
 
-		log.Printf("[api] Capture success: %s +%dXP (level=%d)", player.Name, word.Points, newLevel)
+		events = core.CaptureEvents(
+			core.Player{ID: player.ID, Name: player.Name, XP: newXP, Level: newLevel},
+			core.Word{ID: word.ID, Text: word.Text, Rarity: core.Rarity(word.Rarity), Points: word.Points},
+			previousLevel,
+		)
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// Publier après le commit de la transaction
+	s.bus.Publish(events...)
+	return nil
 }
 
 // ListByPlayer récupère les captures d'un joueur
-func (s *GORMStore) ListByPlayer(playerId string) ([]core.Word, error) {
+func (s *GORMStore) ListByPlayer(ctx context.Context, playerId string) ([]core.Word, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
 	var captures []models.Capture
 
-	if err := s.db.Preload("Word").Where("player_id = ?", playerId).Find(&captures).Error; err != nil {
-		return nil, fmt.Errorf("erreur récupération captures: %w", err)
+	if err := db.Preload("Word").Where("player_id = ?", playerId).Find(&captures).Error; err != nil {
+		return nil, wrapErr(err, "erreur récupération captures")
 	}
 
 	words := make([]core.Word, len(captures))
@@ -279,3 +485,346 @@ func (s *GORMStore) ListByPlayer(playerId string) ([]core.Word, error) {
 
 	return words, nil
 }
+
+// === WEBHOOK REPOSITORY ===
+
+// CreateWebhook enregistre un abonnement webhook
+func (s *GORMStore) CreateWebhook(ctx context.Context, sub *webhook.Subscription) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	model := models.Webhook{
+		ID:        sub.ID,
+		URL:       sub.URL,
+		Secret:    sub.Secret,
+		Events:    strings.Join(sub.Events, ","),
+		Active:    sub.Active,
+		CreatedAt: sub.CreatedAt,
+	}
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = time.Now().UTC()
+	}
+
+	if err := db.Create(&model).Error; err != nil {
+		return wrapErr(err, "erreur création webhook")
+	}
+
+	sub.ID = model.ID
+	sub.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// GetWebhook récupère un abonnement par ID
+func (s *GORMStore) GetWebhook(ctx context.Context, id string) (*webhook.Subscription, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
+	var model models.Webhook
+
+	if err := db.First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notFound("webhook introuvable: %s", id)
+		}
+		return nil, wrapErr(err, "erreur récupération webhook")
+	}
+
+	sub := webhookFromModel(model)
+	return &sub, nil
+}
+
+// ListWebhooks récupère tous les abonnements
+func (s *GORMStore) ListWebhooks(ctx context.Context) ([]webhook.Subscription, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
+	var hooks []models.Webhook
+
+	if err := db.Order("created_at").Find(&hooks).Error; err != nil {
+		return nil, wrapErr(err, "erreur liste webhooks")
+	}
+
+	result := make([]webhook.Subscription, len(hooks))
+	for i, h := range hooks {
+		result[i] = webhookFromModel(h)
+	}
+
+	return result, nil
+}
+
+// DeleteWebhook supprime un abonnement et son journal de livraisons
+func (s *GORMStore) DeleteWebhook(ctx context.Context, id string) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	result := db.Delete(&models.Webhook{}, "id = ?", id)
+	if result.Error != nil {
+		return wrapErr(result.Error, "erreur suppression webhook")
+	}
+	if result.RowsAffected == 0 {
+		return notFound("webhook introuvable: %s", id)
+	}
+	return nil
+}
+
+// SaveDelivery insère ou met à jour une livraison dans le journal
+func (s *GORMStore) SaveDelivery(ctx context.Context, d *webhook.Delivery) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	model := models.WebhookDelivery{
+		ID:          d.ID,
+		WebhookID:   d.WebhookID,
+		Event:       d.Event,
+		Payload:     d.Payload,
+		Status:      d.Status,
+		Attempts:    d.Attempts,
+		StatusCode:  d.StatusCode,
+		LastError:   d.LastError,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+		DeliveredAt: d.DeliveredAt,
+	}
+
+	if err := db.Omit("Webhook").Save(&model).Error; err != nil {
+		return wrapErr(err, "erreur enregistrement livraison")
+	}
+	return nil
+}
+
+// ListDeliveries récupère les dernières livraisons d'un abonnement, filtrées par statut si fourni
+func (s *GORMStore) ListDeliveries(ctx context.Context, webhookID, status string, limit int) ([]webhook.Delivery, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
+	var rows []models.WebhookDelivery
+
+	query := db.Where("webhook_id = ?", webhookID).Order("created_at DESC")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, wrapErr(err, "erreur récupération livraisons")
+	}
+
+	deliveries := make([]webhook.Delivery, len(rows))
+	for i, r := range rows {
+		deliveries[i] = webhook.Delivery{
+			ID:          r.ID,
+			WebhookID:   r.WebhookID,
+			Event:       r.Event,
+			Payload:     r.Payload,
+			Status:      r.Status,
+			Attempts:    r.Attempts,
+			StatusCode:  r.StatusCode,
+			LastError:   r.LastError,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+			DeliveredAt: r.DeliveredAt,
+		}
+	}
+
+	return deliveries, nil
+}
+
+// webhookFromModel convertit un modèle GORM en abonnement
+func webhookFromModel(m models.Webhook) webhook.Subscription {
+	sub := webhook.Subscription{
+		ID:        m.ID,
+		URL:       m.URL,
+		Secret:    m.Secret,
+		Active:    m.Active,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.Events != "" {
+		sub.Events = strings.Split(m.Events, ",")
+	}
+	return sub
+}
+
+// === TOKEN REPOSITORY ===
+
+// SaveToken enregistre l'empreinte d'un jeton de joueur
+func (s *GORMStore) SaveToken(ctx context.Context, t *auth.Token) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	model := models.PlayerToken{
+		ID:        t.ID,
+		PlayerID:  t.PlayerID,
+		TokenHash: t.Hash,
+		CreatedAt: t.CreatedAt,
+	}
+
+	if err := db.Omit("Player").Create(&model).Error; err != nil {
+		return wrapErr(err, "erreur création jeton")
+	}
+	return nil
+}
+
+// GetToken récupère un jeton par ID
+func (s *GORMStore) GetToken(ctx context.Context, id string) (*auth.Token, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
+	var model models.PlayerToken
+
+	if err := db.First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, tokenNotFound(id)
+		}
+		return nil, wrapErr(err, "erreur récupération jeton")
+	}
+
+	return &auth.Token{
+		ID:        model.ID,
+		PlayerID:  model.PlayerID,
+		Hash:      model.TokenHash,
+		CreatedAt: model.CreatedAt,
+		RevokedAt: model.RevokedAt,
+	}, nil
+}
+
+// RevokeToken révoque un jeton (sans effet s'il l'est déjà)
+func (s *GORMStore) RevokeToken(ctx context.Context, id string, at time.Time) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	var model models.PlayerToken
+	if err := db.First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return notFound("jeton introuvable: %s", id)
+		}
+		return wrapErr(err, "erreur récupération jeton")
+	}
+	if model.RevokedAt != nil {
+		return nil
+	}
+
+	if err := db.Model(&model).Update("revoked_at", at).Error; err != nil {
+		return wrapErr(err, "erreur révocation jeton")
+	}
+	return nil
+}
+
+// RevokePlayerTokens révoque tous les jetons actifs d'un joueur
+func (s *GORMStore) RevokePlayerTokens(ctx context.Context, playerID string, at time.Time) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	err := db.Model(&models.PlayerToken{}).
+		Where("player_id = ? AND revoked_at IS NULL", playerID).
+		Update("revoked_at", at).Error
+	if err != nil {
+		return wrapErr(err, "erreur révocation jetons")
+	}
+	return nil
+}
+
+// === SAUVEGARDE ===
+
+// ListWords retourne le catalogue trié par ID
+func (s *GORMStore) ListWords(ctx context.Context) ([]core.Word, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
+	var gormWords []models.Word
+	if err := db.Order("id").Find(&gormWords).Error; err != nil {
+		return nil, wrapErr(err, "erreur récupération mots")
+	}
+
+	words := make([]core.Word, len(gormWords))
+	for i, w := range gormWords {
+		words[i] = core.Word{ID: w.ID, Text: w.Text, Rarity: core.Rarity(w.Rarity), Points: w.Points}
+	}
+	return words, nil
+}
+
+// ListCaptures retourne les captures datées d'un joueur, dans l'ordre chronologique
+func (s *GORMStore) ListCaptures(ctx context.Context, playerID string) ([]Capture, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
+	var gormCaptures []models.Capture
+	if err := db.Where("player_id = ?", playerID).Order("captured_at, id").Find(&gormCaptures).Error; err != nil {
+		return nil, wrapErr(err, "erreur récupération captures")
+	}
+
+	captures := make([]Capture, len(gormCaptures))
+	for i, c := range gormCaptures {
+		captures[i] = Capture{WordID: c.WordID, CapturedAt: c.CapturedAt}
+	}
+	return captures, nil
+}
+
+// ListTokens retourne les jetons d'un joueur par date de création
+func (s *GORMStore) ListTokens(ctx context.Context, playerID string) ([]auth.Token, error) {
+	db, cancel := s.session(ctx, s.timeouts.read)
+	defer cancel()
+
+	var gormTokens []models.PlayerToken
+	if err := db.Where("player_id = ?", playerID).Order("created_at").Find(&gormTokens).Error; err != nil {
+		return nil, wrapErr(err, "erreur récupération jetons")
+	}
+
+	tokens := make([]auth.Token, len(gormTokens))
+	for i, m := range gormTokens {
+		tokens[i] = auth.Token{
+			ID:        m.ID,
+			PlayerID:  m.PlayerID,
+			Hash:      m.TokenHash,
+			CreatedAt: m.CreatedAt,
+			RevokedAt: m.RevokedAt,
+		}
+	}
+	return tokens, nil
+}
+
+// RestorePlayer insère un joueur tel quel ; l'ID est conservé si c'est un UUID
+func (s *GORMStore) RestorePlayer(ctx context.Context, p core.Player) (string, error) {
+	if err := validateName(p.Name); err != nil {
+		return "", err
+	}
+	if err := validateXP(p.XP, p.Level); err != nil {
+		return "", err
+	}
+	if err := ValidateTeam(p.Team); err != nil {
+		return "", err
+	}
+
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	player := &models.Player{
+		ID:    restoredUUID(p.ID),
+		Name:  p.Name,
+		XP:    p.XP,
+		Level: p.Level,
+		Team:  p.Team,
+	}
+
+	if err := db.Create(player).Error; err != nil {
+		return "", wrapErr(err, "erreur restauration joueur")
+	}
+	return player.ID, nil
+}
+
+// RestoreCapture insère une capture datée sans créditer d'XP
+func (s *GORMStore) RestoreCapture(ctx context.Context, playerID string, capture Capture) error {
+	db, cancel := s.session(ctx, s.timeouts.write)
+	defer cancel()
+
+	model := &models.Capture{
+		PlayerID:   playerID,
+		WordID:     capture.WordID,
+		CapturedAt: capture.CapturedAt,
+	}
+
+	if err := db.Omit("Player", "Word").Create(model).Error; err != nil {
+		return wrapErr(err, "erreur restauration capture")
+	}
+	return nil
+}
@@ -1,26 +1,60 @@
 package store
 
-import "github.com/SamG1008/wordmon-go/internal/core"
+import (
+	"context"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/auth"
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
 
 // PlayerStore interface pour la gestion des joueurs
 type PlayerStore interface {
-	Create(name string) (*core.Player, error)
-	Get(id string) (*core.Player, error)
-	List(limit int) ([]core.Player, error)
-	UpdateXP(id string, newXP int, newLevel int) error
+	Create(ctx context.Context, name string) (*core.Player, error)
+	Get(ctx context.Context, id string) (*core.Player, error)
+	List(ctx context.Context, limit int) ([]core.Player, error)
+	UpdateXP(ctx context.Context, id string, newXP int, newLevel int) error
+	// AddXP ajoute delta (négatif : retire) à l'XP sans descendre sous zéro et
+	// recalcule le niveau, en une seule opération ; retourne la variation appliquée
+	AddXP(ctx context.Context, id string, delta int) (int, error)
+	SetTeam(ctx context.Context, id string, team string) error
+	Delete(ctx context.Context, id string) error // captures et jetons du joueur compris
 }
 
 // WordStore interface pour la gestion des mots
 type WordStore interface {
-	Seed(words []core.Word) error
-	GetWord(id string) (*core.Word, error)
-	RandomByRarity(rarity string) (*core.Word, error)
+	Seed(ctx context.Context, words []core.Word) error
+	GetWord(ctx context.Context, id string) (*core.Word, error)
+	RandomByRarity(ctx context.Context, rarity string) (*core.Word, error)
 }
 
 // CaptureStore interface pour la gestion des captures
 type CaptureStore interface {
-	Add(playerId, wordId string) error
-	ListByPlayer(playerId string) ([]core.Word, error)
+	Add(ctx context.Context, playerId, wordId string) error
+	ListByPlayer(ctx context.Context, playerId string) ([]core.Word, error)
+}
+
+// Capture capture datée d'un mot
+type Capture struct {
+	WordID     string    `json:"wordId"`
+	CapturedAt time.Time `json:"capturedAt"`
+}
+
+// BackupStore lectures et écritures en bloc de wordmon dump et restore
+type BackupStore interface {
+	ListWords(ctx context.Context) ([]core.Word, error)
+	ListCatalog(ctx context.Context) ([]CatalogWord, error) // mots retirés compris
+	ListCatalogVersions(ctx context.Context) ([]CatalogVersion, error)
+	ListCaptures(ctx context.Context, playerID string) ([]Capture, error) // ordre chronologique
+	ListTokens(ctx context.Context, playerID string) ([]auth.Token, error)
+	// RestorePlayer insère un joueur tel quel (XP, niveau, équipe) ; son ID
+	// est conservé si le backend l'accepte, sinon un nouvel ID est retourné
+	RestorePlayer(ctx context.Context, player core.Player) (string, error)
+	// RestoreCatalog ajoute les mots absents tels quels (retirés compris) et
+	// les versions absentes de l'historique du catalogue
+	RestoreCatalog(ctx context.Context, words []CatalogWord, versions []CatalogVersion) error
+	// RestoreCapture insère une capture datée sans créditer d'XP ni publier d'événement
+	RestoreCapture(ctx context.Context, playerID string, capture Capture) error
 }
 
 // Store interface complète
@@ -28,5 +62,8 @@ type Store interface {
 	PlayerStore
 	WordStore
 	CaptureStore
+	LeaderboardStore
+	BackupStore
+	auth.TokenStore
 	Close() error
 }
@@ -0,0 +1,342 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// RankBy critère de classement des joueurs
+type RankBy string
+
+const (
+	RankByXP        RankBy = "xp"        // expérience
+	RankByCaptures  RankBy = "captures"  // nombre total de captures
+	RankByLegendary RankBy = "legendary" // captures de WordMon légendaires
+	RankByDistinct  RankBy = "distinct"  // mots différents capturés
+)
+
+// ParseRankBy valide un critère de classement (xp si vide)
+func ParseRankBy(value string) (RankBy, error) {
+	switch by := RankBy(value); by {
+	case "":
+		return RankByXP, nil
+	case RankByXP, RankByCaptures, RankByLegendary, RankByDistinct:
+		return by, nil
+	default:
+		return "", invalid("critère de classement inconnu: %s (xp, captures, legendary ou distinct)", value)
+	}
+}
+
+// Cursor position dans un classement : la page suivante commence après
+// ce score et cet ID
+type Cursor struct {
+	Score int
+	ID    string
+}
+
+// String encode le curseur pour un paramètre de requête
+func (c Cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(c.Score) + ":" + c.ID))
+}
+
+// ParseCursor décode un curseur produit par Cursor.String
+func ParseCursor(value string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, invalid("curseur invalide")
+	}
+	score, id, ok := strings.Cut(string(raw), ":")
+	n, err := strconv.Atoi(score)
+	if !ok || err != nil || id == "" {
+		return nil, invalid("curseur invalide")
+	}
+	return &Cursor{Score: n, ID: id}, nil
+}
+
+// LeaderboardQuery critère, filtres et pagination d'un classement
+type LeaderboardQuery struct {
+	By       RankBy
+	Team     string  // "" : toutes les équipes
+	MinLevel int     // 0 : pas de borne
+	MaxLevel int     // 0 : pas de borne
+	After    *Cursor // nil : première page
+	Limit    int     // <= 0 : tous les joueurs
+}
+
+// Validate vérifie le critère et les bornes de niveau
+func (q LeaderboardQuery) Validate() error {
+	if _, err := ParseRankBy(string(q.By)); err != nil {
+		return err
+	}
+	if q.MinLevel < 0 || q.MaxLevel < 0 || (q.MaxLevel > 0 && q.MinLevel > q.MaxLevel) {
+		return invalid("bornes de niveau invalides: %d-%d", q.MinLevel, q.MaxLevel)
+	}
+	return nil
+}
+
+// filtered indique si un filtre d'équipe ou de niveau est actif
+func (q LeaderboardQuery) filtered() bool {
+	return q.Team != "" || q.MinLevel > 0 || q.MaxLevel > 0
+}
+
+// matches indique si le joueur passe les filtres d'équipe et de niveau
+func (q LeaderboardQuery) matches(player *core.Player) bool {
+	return (q.Team == "" || player.Team == q.Team) &&
+		player.Level >= q.MinLevel &&
+		(q.MaxLevel == 0 || player.Level <= q.MaxLevel)
+}
+
+// RankedPlayer joueur et sa place dans un classement
+type RankedPlayer struct {
+	core.Player
+	Rank  int // rang dense : les ex aequo partagent le rang, le suivant vient juste après
+	Score int // valeur du critère de classement
+}
+
+// LeaderboardPage page d'un classement
+type LeaderboardPage struct {
+	Players []RankedPlayer
+	Total   int     // joueurs retenus par les filtres
+	Next    *Cursor // nil sur la dernière page
+}
+
+// PlayerRank place d'un joueur et ses voisins dans un classement
+type PlayerRank struct {
+	RankedPlayer
+	Total      int
+	Percentile float64        // part des joueurs au score inférieur ou égal (100 : en tête)
+	Above      []RankedPlayer // joueurs juste devant, du mieux classé au plus proche
+	Below      []RankedPlayer // joueurs juste derrière, du plus proche au moins bien classé
+}
+
+// LeaderboardStore interface pour les classements paginés
+type LeaderboardStore interface {
+	Leaderboard(ctx context.Context, q LeaderboardQuery) (*LeaderboardPage, error)
+	PlayerRank(ctx context.Context, id string, q LeaderboardQuery, neighbors int) (*PlayerRank, error)
+}
+
+// === CLASSEMENT EN MÉMOIRE ===
+
+// denseRanks attribue les rangs denses à des joueurs déjà classés
+func denseRanks(players []RankedPlayer) {
+	for i := range players {
+		switch {
+		case i == 0:
+			players[i].Rank = 1
+		case players[i].Score == players[i-1].Score:
+			players[i].Rank = players[i-1].Rank
+		default:
+			players[i].Rank = players[i-1].Rank + 1
+		}
+	}
+}
+
+// pageOf découpe un classement trié selon le curseur et la limite
+func pageOf(ranked []RankedPlayer, q LeaderboardQuery) *LeaderboardPage {
+	start := 0
+	if q.After != nil {
+		start = sort.Search(len(ranked), func(i int) bool {
+			return after(ranked[i], *q.After)
+		})
+	}
+
+	end := len(ranked)
+	if q.Limit > 0 && start+q.Limit < end {
+		end = start + q.Limit
+	}
+
+	page := &LeaderboardPage{Players: ranked[start:end], Total: len(ranked)}
+	if end < len(ranked) && end > start {
+		last := ranked[end-1]
+		page.Next = &Cursor{Score: last.Score, ID: last.ID}
+	}
+	return page
+}
+
+// after indique si le joueur est classé après la position du curseur
+func after(player RankedPlayer, cursor Cursor) bool {
+	return player.Score < cursor.Score || (player.Score == cursor.Score && player.ID > cursor.ID)
+}
+
+// rankOf retourne la place d'un joueur dans un classement trié
+func rankOf(ranked []RankedPlayer, id string, neighbors int) (*PlayerRank, error) {
+	for i, player := range ranked {
+		if player.ID != id {
+			continue
+		}
+
+		// Joueurs au score inférieur ou égal : le premier ex aequo et ceux qui suivent
+		return &PlayerRank{
+			RankedPlayer: player,
+			Total:        len(ranked),
+			Percentile:   percentile(len(ranked)-first(ranked, i), len(ranked)),
+			Above:        ranked[max(0, i-neighbors):i],
+			Below:        ranked[i+1 : min(len(ranked), i+1+neighbors)],
+		}, nil
+	}
+	return nil, notFound("joueur absent du classement: %s", id)
+}
+
+// first retourne l'indice du premier ex aequo du joueur d'indice i
+func first(ranked []RankedPlayer, i int) int {
+	for i > 0 && ranked[i-1].Score == ranked[i].Score {
+		i--
+	}
+	return i
+}
+
+// percentile arrondit au dixième la part notAbove/total en pourcentage
+func percentile(notAbove, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(notAbove*1000/total) / 10
+}
+
+// === CLASSEMENT SQL ===
+
+// rankScores expression SQL du score de chaque critère (liste fermée :
+// la valeur est insérée dans la requête)
+var rankScores = map[RankBy]string{
+	RankByXP:        "xp",
+	RankByCaptures:  "captures",
+	RankByLegendary: "legendary",
+	RankByDistinct:  "distinct_words",
+}
+
+// rankedCTE classe les joueurs filtrés ($1 équipe, $2 niveau min, $3 niveau max) ;
+// ranked expose id, name, xp, level, team, score, rank, total, not_above
+// (joueurs au score inférieur ou égal) et pos (position dans l'ordre du classement)
+func rankedCTE(by RankBy) string {
+	return fmt.Sprintf(`
+		WITH stats AS (
+			SELECT p.id::text AS id, p.name, p.xp, p.level, p.team,
+				COUNT(c.id) AS captures,
+				COUNT(c.id) FILTER (WHERE w.rarity = '%s') AS legendary,
+				COUNT(DISTINCT c.word_id) AS distinct_words
+			FROM players p
+			LEFT JOIN captures c ON c.player_id = p.id
+			LEFT JOIN words w ON w.id = c.word_id
+			WHERE ($1 = '' OR p.team = $1) AND p.level >= $2 AND ($3 = 0 OR p.level <= $3)
+			GROUP BY p.id
+		), ranked AS (
+			SELECT id, name, xp, level, team, %s AS score,
+				DENSE_RANK() OVER (ORDER BY %[2]s DESC) AS rank,
+				COUNT(*) OVER () AS total,
+				COUNT(*) OVER (ORDER BY %[2]s) AS not_above,
+				ROW_NUMBER() OVER (ORDER BY %[2]s DESC, id) AS pos
+			FROM stats
+		)`, core.Legendary, rankScores[by])
+}
+
+// scanRanked lit les lignes id, name, xp, level, team, score, rank, total et not_above
+func scanRanked(rows *sql.Rows) ([]RankedPlayer, []int, int, error) {
+	defer rows.Close()
+
+	var players []RankedPlayer
+	var notAbove []int
+	total := 0
+	for rows.Next() {
+		var player RankedPlayer
+		var n int
+		if err := rows.Scan(&player.ID, &player.Name, &player.XP, &player.Level, &player.Team,
+			&player.Score, &player.Rank, &total, &n); err != nil {
+			return nil, nil, 0, wrapErr(err, "erreur lecture classement")
+		}
+		players = append(players, player)
+		notAbove = append(notAbove, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, 0, wrapErr(err, "erreur lecture classement")
+	}
+	return players, notAbove, total, nil
+}
+
+// sqlLeaderboard calcule une page du classement dans Postgres
+func sqlLeaderboard(ctx context.Context, db *sql.DB, q LeaderboardQuery) (*LeaderboardPage, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	by, _ := ParseRankBy(string(q.By))
+
+	cursor := Cursor{}
+	if q.After != nil {
+		cursor = *q.After
+	}
+	query := rankedCTE(by) + `
+		SELECT id, name, xp, level, team, score, rank, total, not_above FROM ranked
+		WHERE NOT $4 OR score < $5 OR (score = $5 AND id > $6)
+		ORDER BY pos
+		LIMIT NULLIF($7, 0)`
+
+	// Une ligne de plus que la limite indique s'il reste une page
+	limit := 0
+	if q.Limit > 0 {
+		limit = q.Limit + 1
+	}
+	rows, err := db.QueryContext(ctx, query, q.Team, q.MinLevel, q.MaxLevel, q.After != nil, cursor.Score, cursor.ID, limit)
+	if err != nil {
+		return nil, wrapErr(err, "erreur récupération classement")
+	}
+	players, _, total, err := scanRanked(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &LeaderboardPage{Players: players, Total: total}
+	if q.Limit > 0 && len(players) > q.Limit {
+		page.Players = players[:q.Limit]
+		last := page.Players[q.Limit-1]
+		page.Next = &Cursor{Score: last.Score, ID: last.ID}
+	}
+	if total == 0 && q.After != nil {
+		// Page vide après le curseur : le total reste celui du classement
+		if err := db.QueryRowContext(ctx, rankedCTE(by)+` SELECT COUNT(*) FROM ranked`, q.Team, q.MinLevel, q.MaxLevel).Scan(&page.Total); err != nil {
+			return nil, wrapErr(err, "erreur récupération classement")
+		}
+	}
+	return page, nil
+}
+
+// sqlPlayerRank calcule la place d'un joueur et de ses voisins dans Postgres
+func sqlPlayerRank(ctx context.Context, db *sql.DB, id string, q LeaderboardQuery, neighbors int) (*PlayerRank, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	by, _ := ParseRankBy(string(q.By))
+
+	query := rankedCTE(by) + `
+		SELECT r.id, r.name, r.xp, r.level, r.team, r.score, r.rank, r.total, r.not_above
+		FROM ranked r, ranked me
+		WHERE me.id = $4 AND r.pos BETWEEN me.pos - $5 AND me.pos + $5
+		ORDER BY r.pos`
+
+	rows, err := db.QueryContext(ctx, query, q.Team, q.MinLevel, q.MaxLevel, id, neighbors)
+	if err != nil {
+		return nil, wrapErr(err, "erreur récupération rang")
+	}
+	players, notAbove, total, err := scanRanked(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, player := range players {
+		if player.ID == id {
+			return &PlayerRank{
+				RankedPlayer: player,
+				Total:        total,
+				Percentile:   percentile(notAbove[i], total),
+				Above:        players[:i],
+				Below:        players[i+1:],
+			}, nil
+		}
+	}
+	return nil, notFound("joueur absent du classement: %s", id)
+}
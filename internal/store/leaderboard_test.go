@@ -0,0 +1,155 @@
+package store
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// newRankedStore crée quatre joueurs : Alice et Bob ex aequo en XP,
+// Chloé seule à avoir capturé un légendaire
+func newRankedStore(t *testing.T) (*MemoryStore, map[string]string) {
+	t.Helper()
+	ctx := t.Context()
+	s := NewMemoryStore()
+	s.Seed(ctx, []core.Word{
+		{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5},
+		{ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 50},
+	})
+
+	ids := map[string]string{}
+	for _, name := range []string{"Alice", "Bob", "Chloé", "David"} {
+		player, err := s.Create(ctx, name)
+		if err != nil {
+			t.Fatalf("création %s: %v", name, err)
+		}
+		ids[name] = player.ID
+	}
+	s.SetTeam(ctx, ids["Alice"], "rouge")
+	s.SetTeam(ctx, ids["Chloé"], "rouge")
+
+	s.UpdateXP(ctx, ids["Alice"], 100, 2)
+	s.UpdateXP(ctx, ids["Bob"], 100, 2)
+	s.UpdateXP(ctx, ids["David"], 10, 1)
+	s.Add(ctx, ids["Chloé"], "l_1")
+	s.Add(ctx, ids["Alice"], "c_1")
+	s.Add(ctx, ids["Alice"], "c_1")
+	return s, ids
+}
+
+func TestLeaderboardDenseRanksAndModes(t *testing.T) {
+	ctx := t.Context()
+	s, ids := newRankedStore(t)
+
+	page, err := s.Leaderboard(ctx, LeaderboardQuery{By: RankByXP})
+	if err != nil {
+		t.Fatalf("classement: %v", err)
+	}
+	// Alice 110 ; Bob 100 ; Chloé 50 ; David 10
+	wantRanks := []int{1, 2, 3, 4}
+	for i, player := range page.Players {
+		if player.Rank != wantRanks[i] {
+			t.Errorf("xp: %s rang %d, attendu %d", player.Name, player.Rank, wantRanks[i])
+		}
+	}
+
+	// Captures : Alice 2, Chloé 1, Bob et David 0 (ex aequo au rang 3)
+	page, _ = s.Leaderboard(ctx, LeaderboardQuery{By: RankByCaptures})
+	last := page.Players[3]
+	if page.Players[2].Rank != 3 || last.Rank != 3 || last.Score != 0 {
+		t.Errorf("captures: rangs denses attendus, obtenu %+v", page.Players)
+	}
+
+	page, _ = s.Leaderboard(ctx, LeaderboardQuery{By: RankByDistinct, Team: "rouge"})
+	if page.Total != 2 || page.Players[0].ID != ids["Alice"] || page.Players[0].Rank != page.Players[1].Rank {
+		t.Errorf("distinct/rouge: Alice et Chloé ex aequo attendus, obtenu %+v", page.Players)
+	}
+
+	page, _ = s.Leaderboard(ctx, LeaderboardQuery{By: RankByLegendary, MinLevel: 2})
+	if page.Total != 2 || page.Players[0].Score != 0 {
+		t.Errorf("legendary niveau 2+: Chloé (niveau 1) exclue, obtenu %+v", page.Players)
+	}
+
+	if _, err := s.Leaderboard(ctx, LeaderboardQuery{MinLevel: 3, MaxLevel: 2}); !errors.Is(err, ErrInvalid) {
+		t.Errorf("bornes inversées: ErrInvalid attendu, obtenu %v", err)
+	}
+}
+
+func TestLeaderboardCursorPagination(t *testing.T) {
+	ctx := t.Context()
+	s, _ := newRankedStore(t)
+
+	var seen []string
+	query := LeaderboardQuery{Limit: 3}
+	for {
+		page, err := s.Leaderboard(ctx, query)
+		if err != nil {
+			t.Fatalf("page: %v", err)
+		}
+		for _, player := range page.Players {
+			seen = append(seen, player.Name)
+		}
+		if page.Next == nil {
+			break
+		}
+		// Le curseur survit à l'aller-retour par la requête HTTP
+		query.After, err = ParseCursor(page.Next.String())
+		if err != nil {
+			t.Fatalf("curseur: %v", err)
+		}
+	}
+
+	if len(seen) != 4 || seen[0] != "Alice" || seen[3] != "David" {
+		t.Errorf("pages: tous les joueurs une seule fois attendus, obtenu %v", seen)
+	}
+}
+
+func TestPlayerRankNeighborsAndPercentile(t *testing.T) {
+	ctx := t.Context()
+	s, ids := newRankedStore(t)
+
+	rank, err := s.PlayerRank(ctx, ids["Bob"], LeaderboardQuery{}, 1)
+	if err != nil {
+		t.Fatalf("rang: %v", err)
+	}
+	if rank.Rank != 2 || rank.Total != 4 || rank.Percentile != 75 {
+		t.Errorf("Bob: rang 2 sur 4 et 75%% attendus, obtenu %+v", rank)
+	}
+	if len(rank.Above) != 1 || rank.Above[0].ID != ids["Alice"] || len(rank.Below) != 1 || rank.Below[0].ID != ids["Chloé"] {
+		t.Errorf("voisins inattendus: %+v / %+v", rank.Above, rank.Below)
+	}
+
+	if _, err := s.PlayerRank(ctx, ids["Bob"], LeaderboardQuery{Team: "rouge"}, 1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("joueur filtré: ErrNotFound attendu, obtenu %v", err)
+	}
+}
+
+func TestLeaderboardIndexFollowsConcurrentCaptures(t *testing.T) {
+	ctx := t.Context()
+	s, ids := newRankedStore(t)
+
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				s.Add(ctx, id, "c_1")
+				s.Leaderboard(ctx, LeaderboardQuery{Limit: 2})
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	// L'index doit refléter l'XP réelle de chaque joueur
+	players, _ := s.List(ctx, 0)
+	page, _ := s.Leaderboard(ctx, LeaderboardQuery{})
+	for i, player := range players {
+		stored, _ := s.Get(ctx, player.ID)
+		if page.Players[i].ID != player.ID || page.Players[i].Score != stored.XP {
+			t.Errorf("position %d: index %s/%d, store %s/%d", i, page.Players[i].ID, page.Players[i].Score, stored.ID, stored.XP)
+		}
+	}
+}
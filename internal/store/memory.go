@@ -1,42 +1,110 @@
 package store
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/SamG1008/wordmon-go/internal/auth"
 	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+	"github.com/google/uuid"
 )
 
 // MemoryStore stocke les données en mémoire
 type MemoryStore struct {
 	mu           sync.RWMutex
 	players      map[string]*core.Player
-	currentSpawn *core.Word
-	startTime    time.Time
+	names        map[string]string // nom -> ID (unicité des noms)
+	words        map[string]core.Word
+	retired      map[string]bool      // mots retirés du catalogue (SyncWords)
+	versions     []CatalogVersion     // historique des versions du catalogue
+	captures     map[string][]Capture // ID joueur -> captures datées, dans l'ordre
 	nextPlayerID int
+	bus          *core.EventBus
+	webhooks     map[string]webhook.Subscription
+	tokens       map[string]auth.Token
+	deliveries   []webhook.Delivery
+	rankings     map[RankBy]*rankIndex // un index ordonné par critère de classement
 }
 
+// MemoryStore implémente l'interface complète
+var _ Store = (*MemoryStore)(nil)
+
 // NewMemoryStore crée un nouveau store en mémoire
 func NewMemoryStore() *MemoryStore {
 	return &MemoryStore{
 		players:      make(map[string]*core.Player),
-		startTime:    time.Now(),
+		names:        make(map[string]string),
+		words:        make(map[string]core.Word),
+		retired:      make(map[string]bool),
+		captures:     make(map[string][]Capture),
+		webhooks:     make(map[string]webhook.Subscription),
+		tokens:       make(map[string]auth.Token),
 		nextPlayerID: 1,
+		rankings: map[RankBy]*rankIndex{
+			RankByXP:        newRankIndex(),
+			RankByCaptures:  newRankIndex(),
+			RankByLegendary: newRankIndex(),
+			RankByDistinct:  newRankIndex(),
+		},
+	}
+}
+
+// Close implémente Store (rien à libérer)
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+// Reset vide le store (le bus d'événements est conservé), par exemple après
+// un import partiel
+func (s *MemoryStore) Reset() {
+	fresh := NewMemoryStore()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.players, s.names, s.words, s.retired = fresh.players, fresh.names, fresh.words, fresh.retired
+	s.versions, s.captures, s.nextPlayerID = nil, fresh.captures, fresh.nextPlayerID
+	s.webhooks, s.tokens, s.deliveries = fresh.webhooks, fresh.tokens, nil
+	s.rankings = fresh.rankings
+}
+
+// SetEventBus définit le bus sur lequel le store publie ses événements
+func (s *MemoryStore) SetEventBus(bus *core.EventBus) {
+	s.bus = bus
+}
+
+// === PLAYER REPOSITORY ===
+
+// Create crée un nouveau joueur (nom unique)
+func (s *MemoryStore) Create(ctx context.Context, name string) (*core.Player, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
 	}
+
+	player, err := s.create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Publier hors du verrou (les abonnés synchrones peuvent relire le store)
+	s.bus.Publish(core.PlayerCreated{PlayerID: player.ID, Name: player.Name, At: time.Now().UTC()})
+	return player, nil
 }
 
-// CreatePlayer crée un nouveau joueur
-func (s *MemoryStore) CreatePlayer(name string) (*core.Player, error) {
+// create inscrit le joueur sous le verrou, sans publier
+func (s *MemoryStore) create(name string) (*core.Player, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// Vérifier si le nom est déjà pris
-	for _, player := range s.players {
-		if player.Name == name {
-			return nil, fmt.Errorf("nom déjà pris: %s", name)
-		}
+	if _, taken := s.names[name]; taken {
+		return nil, conflict("nom déjà pris: %s", name)
 	}
 
 	// Créer le joueur avec un ID unique
@@ -45,95 +113,791 @@ func (s *MemoryStore) CreatePlayer(name string) (*core.Player, error) {
 
 	player := core.NewPlayer(playerID, name)
 	s.players[playerID] = &player
+	s.names[name] = playerID
+	for _, idx := range s.rankings {
+		idx.Set(playerID, 0)
+	}
 
-	return &player, nil
+	return copyPlayer(&player), nil
 }
 
-// GetPlayer récupère un joueur par ID
-func (s *MemoryStore) GetPlayer(id string) (*core.Player, error) {
+// Get récupère une copie du joueur (inventaire compris)
+func (s *MemoryStore) Get(ctx context.Context, id string) (*core.Player, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	player, exists := s.players[id]
 	if !exists {
-		return nil, fmt.Errorf("joueur non trouvé: %s", id)
+		return nil, notFound("joueur non trouvé: %s", id)
 	}
 
-	return player, nil
+	return copyPlayer(player), nil
+}
+
+// List retourne les joueurs par XP décroissant (tous si limit <= 0), lus
+// dans l'ordre de l'index XP
+func (s *MemoryStore) List(ctx context.Context, limit int) ([]core.Player, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx := s.rankings[RankByXP]
+	size := idx.Len()
+	if limit > 0 && limit < size {
+		size = limit
+	}
+
+	players := make([]core.Player, 0, size)
+	idx.Range(0, func(id string, _ int) bool {
+		player := s.players[id]
+		players = append(players, core.Player{ID: id, Name: player.Name, XP: player.XP, Level: player.Level, Team: player.Team})
+		return len(players) < size
+	})
+	return players, nil
+}
+
+// UpdateXP met à jour l'XP et le niveau d'un joueur
+func (s *MemoryStore) UpdateXP(ctx context.Context, id string, newXP int, newLevel int) error {
+	if err := validateXP(newXP, newLevel); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, exists := s.players[id]
+	if !exists {
+		return notFound("joueur non trouvé: %s", id)
+	}
+
+	player.XP = newXP
+	player.Level = newLevel
+	s.rankings[RankByXP].Set(id, newXP)
+	return nil
+}
+
+// AddXP ajoute delta à l'XP d'un joueur (plancher à 0) et recalcule son niveau
+func (s *MemoryStore) AddXP(ctx context.Context, id string, delta int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, exists := s.players[id]
+	if !exists {
+		return 0, notFound("joueur non trouvé: %s", id)
+	}
+
+	xp := max(player.XP+delta, 0)
+	applied := xp - player.XP
+	player.XP = xp
+	player.Level = core.LevelFromXP(xp)
+	s.rankings[RankByXP].Set(id, xp)
+	return applied, nil
 }
 
-// UpdatePlayer met à jour un joueur
-func (s *MemoryStore) UpdatePlayer(player *core.Player) error {
+// Delete supprime un joueur, ses captures et ses jetons
+func (s *MemoryStore) Delete(ctx context.Context, id string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if _, exists := s.players[player.ID]; !exists {
-		return fmt.Errorf("joueur non trouvé: %s", player.ID)
+	player, exists := s.players[id]
+	if !exists {
+		return notFound("joueur non trouvé: %s", id)
+	}
+
+	delete(s.names, player.Name)
+	delete(s.players, id)
+	delete(s.captures, id)
+	for _, idx := range s.rankings {
+		idx.Remove(id)
+	}
+	for tokenID, token := range s.tokens {
+		if token.PlayerID == id {
+			delete(s.tokens, tokenID)
+		}
+	}
+	return nil
+}
+
+// SetTeam change l'équipe d'un joueur ("" : aucune)
+func (s *MemoryStore) SetTeam(ctx context.Context, id string, team string) error {
+	if err := ValidateTeam(team); err != nil {
+		return err
 	}
 
-	s.players[player.ID] = player
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, exists := s.players[id]
+	if !exists {
+		return notFound("joueur non trouvé: %s", id)
+	}
+	player.Team = team
 	return nil
 }
 
-// GetAllPlayers retourne tous les joueurs
-func (s *MemoryStore) GetAllPlayers() []*core.Player {
+// Leaderboard retourne une page du classement filtré. Sans filtre, la page
+// est lue dans l'index du critère en O(log n + limit)
+func (s *MemoryStore) Leaderboard(ctx context.Context, q LeaderboardQuery) (*LeaderboardPage, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	players := make([]*core.Player, 0, len(s.players))
-	for _, player := range s.players {
-		players = append(players, player)
+	if q.filtered() {
+		return pageOf(s.filteredRanking(q), q), nil
+	}
+
+	idx := s.index(q.By)
+	start := 0
+	if q.After != nil {
+		start = idx.PositionAfter(*q.After)
+	}
+
+	page := &LeaderboardPage{Total: idx.Len()}
+	idx.Range(start, func(id string, score int) bool {
+		if q.Limit > 0 && len(page.Players) == q.Limit {
+			last := page.Players[q.Limit-1]
+			page.Next = &Cursor{Score: last.Score, ID: last.ID}
+			return false
+		}
+		page.Players = append(page.Players, s.rankedPlayer(id, score, idx.DenseRank(score)))
+		return true
+	})
+	return page, nil
+}
+
+// PlayerRank retourne la place d'un joueur et ses voisins dans le classement
+// filtré. Sans filtre, tout est lu dans l'index en O(log n + neighbors)
+func (s *MemoryStore) PlayerRank(ctx context.Context, id string, q LeaderboardQuery, neighbors int) (*PlayerRank, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if q.filtered() {
+		return rankOf(s.filteredRanking(q), id, neighbors)
+	}
+
+	idx := s.index(q.By)
+	pos, exists := idx.Position(id)
+	if !exists {
+		return nil, notFound("joueur absent du classement: %s", id)
+	}
+
+	rank := &PlayerRank{Total: idx.Len()}
+	i := max(0, pos-neighbors)
+	idx.Range(i, func(otherID string, score int) bool {
+		player := s.rankedPlayer(otherID, score, idx.DenseRank(score))
+		switch {
+		case i < pos:
+			rank.Above = append(rank.Above, player)
+		case i == pos:
+			rank.RankedPlayer = player
+			rank.Percentile = percentile(idx.NotAbove(score), idx.Len())
+		default:
+			rank.Below = append(rank.Below, player)
+		}
+		i++
+		return i <= pos+neighbors
+	})
+	return rank, nil
+}
+
+// filteredRanking parcourt l'index du critère et garde les joueurs retenus
+// par les filtres, avec leur rang dense parmi eux (verrou en lecture requis)
+func (s *MemoryStore) filteredRanking(q LeaderboardQuery) []RankedPlayer {
+	var players []RankedPlayer
+	s.index(q.By).Range(0, func(id string, score int) bool {
+		if q.matches(s.players[id]) {
+			players = append(players, s.rankedPlayer(id, score, 0))
+		}
+		return true
+	})
+	denseRanks(players)
 	return players
 }
 
-// GetLeaderboard retourne le classement des joueurs
-func (s *MemoryStore) GetLeaderboard(limit int) []*core.Player {
-	players := s.GetAllPlayers()
+// index retourne l'index d'un critère (XP par défaut)
+func (s *MemoryStore) index(by RankBy) *rankIndex {
+	if idx, exists := s.rankings[by]; exists {
+		return idx
+	}
+	return s.rankings[RankByXP]
+}
+
+// rankedPlayer copie un joueur classé, sans inventaire (verrou en lecture requis)
+func (s *MemoryStore) rankedPlayer(id string, score, rank int) RankedPlayer {
+	player := s.players[id]
+	return RankedPlayer{
+		Player: core.Player{ID: id, Name: player.Name, XP: player.XP, Level: player.Level, Team: player.Team},
+		Rank:   rank,
+		Score:  score,
+	}
+}
+
+// copyPlayer copie un joueur et son inventaire
+func copyPlayer(player *core.Player) *core.Player {
+	copied := *player
+	copied.Inventory = make(map[string]int, len(player.Inventory))
+	for wordID, count := range player.Inventory {
+		copied.Inventory[wordID] = count
+	}
+	return &copied
+}
+
+// === WORD REPOSITORY ===
 
-	// Trier par XP décroissant
-	sort.Slice(players, func(i, j int) bool {
-		return players[i].XP > players[j].XP
+// Seed ajoute les mots absents du catalogue
+func (s *MemoryStore) Seed(ctx context.Context, words []core.Word) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, word := range words {
+		if _, exists := s.words[word.ID]; !exists {
+			s.words[word.ID] = word
+		}
+	}
+	return nil
+}
+
+// GetWord récupère un mot par ID
+func (s *MemoryStore) GetWord(ctx context.Context, id string) (*core.Word, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	word, exists := s.words[id]
+	if !exists {
+		return nil, notFound("mot non trouvé: %s", id)
+	}
+	return &word, nil
+}
+
+// RandomByRarity retourne un mot aléatoire de la rareté demandée
+func (s *MemoryStore) RandomByRarity(ctx context.Context, rarity string) (*core.Word, error) {
+	s.mu.RLock()
+	var candidates []core.Word
+	for _, word := range s.words {
+		if string(word.Rarity) == rarity && !s.retired[word.ID] {
+			candidates = append(candidates, word)
+		}
+	}
+	s.mu.RUnlock()
+
+	if len(candidates) == 0 {
+		return nil, notFound("aucun mot trouvé pour rareté: %s", rarity)
+	}
+
+	word := candidates[rand.Intn(len(candidates))]
+	return &word, nil
+}
+
+// === CAPTURE REPOSITORY ===
+
+// Add enregistre une capture et crédite les points du mot au joueur
+func (s *MemoryStore) Add(ctx context.Context, playerID, wordID string) error {
+	events, err := s.addAt(playerID, wordID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	// Publier hors du verrou (les abonnés synchrones peuvent relire le store)
+	s.bus.Publish(events...)
+	return nil
+}
+
+// addAt enregistre une capture datée de at sans publier (rejeu du journal du
+// FileStore) et retourne les événements correspondants
+func (s *MemoryStore) addAt(playerID, wordID string, at time.Time) ([]core.DomainEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	player, exists := s.players[playerID]
+	if !exists {
+		return nil, notFound("joueur non trouvé: %s", playerID)
+	}
+	word, exists := s.words[wordID]
+	if !exists {
+		return nil, notFound("mot non trouvé: %s", wordID)
+	}
+
+	previousLevel := player.Level
+	player.AwardXP(word.Points)
+	if player.Inventory == nil {
+		player.Inventory = make(map[string]int)
+	}
+	player.Inventory[wordID]++
+	s.captures[playerID] = append(s.captures[playerID], Capture{WordID: wordID, CapturedAt: at})
+	s.indexCapture(player, word)
+	return core.CaptureEvents(*copyPlayer(player), word, previousLevel), nil
+}
+
+// indexCapture met à jour les index de classement après une capture
+// (verrou en écriture requis)
+func (s *MemoryStore) indexCapture(player *core.Player, word core.Word) {
+	s.rankings[RankByXP].Set(player.ID, player.XP)
+	s.rankings[RankByCaptures].Add(player.ID, 1)
+	if word.Rarity == core.Legendary {
+		s.rankings[RankByLegendary].Add(player.ID, 1)
+	}
+	if player.Inventory[word.ID] == 1 {
+		s.rankings[RankByDistinct].Add(player.ID, 1)
+	}
+}
+
+// ListByPlayer retourne les mots capturés par un joueur, dans l'ordre des captures
+func (s *MemoryStore) ListByPlayer(ctx context.Context, playerID string) ([]core.Word, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	words := make([]core.Word, 0, len(s.captures[playerID]))
+	for _, capture := range s.captures[playerID] {
+		words = append(words, s.words[capture.WordID])
+	}
+	return words, nil
+}
+
+// === WEBHOOK REPOSITORY ===
+
+// CreateWebhook enregistre un abonnement webhook
+func (s *MemoryStore) CreateWebhook(ctx context.Context, sub *webhook.Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sub.ID == "" {
+		sub.ID = uuid.New().String()
+	}
+	if sub.CreatedAt.IsZero() {
+		sub.CreatedAt = time.Now().UTC()
+	}
+
+	stored := *sub
+	stored.Events = append([]string(nil), sub.Events...)
+	s.webhooks[sub.ID] = stored
+	return nil
+}
+
+// GetWebhook récupère un abonnement par ID
+func (s *MemoryStore) GetWebhook(ctx context.Context, id string) (*webhook.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sub, exists := s.webhooks[id]
+	if !exists {
+		return nil, notFound("webhook non trouvé: %s", id)
+	}
+	sub.Events = append([]string(nil), sub.Events...)
+	return &sub, nil
+}
+
+// ListWebhooks retourne tous les abonnements, du plus ancien au plus récent
+func (s *MemoryStore) ListWebhooks(ctx context.Context) ([]webhook.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subs := make([]webhook.Subscription, 0, len(s.webhooks))
+	for _, sub := range s.webhooks {
+		sub.Events = append([]string(nil), sub.Events...)
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].CreatedAt.Before(subs[j].CreatedAt)
 	})
 
-	// Limiter le résultat
-	if limit > 0 && limit < len(players) {
-		players = players[:limit]
+	return subs, nil
+}
+
+// DeleteWebhook supprime un abonnement et son journal de livraisons
+func (s *MemoryStore) DeleteWebhook(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.webhooks[id]; !exists {
+		return notFound("webhook non trouvé: %s", id)
 	}
+	delete(s.webhooks, id)
 
-	return players
+	kept := s.deliveries[:0]
+	for _, d := range s.deliveries {
+		if d.WebhookID != id {
+			kept = append(kept, d)
+		}
+	}
+	s.deliveries = kept
+	return nil
+}
+
+// SaveDelivery insère ou met à jour une livraison dans le journal
+func (s *MemoryStore) SaveDelivery(ctx context.Context, d *webhook.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.deliveries {
+		if s.deliveries[i].ID == d.ID {
+			s.deliveries[i] = *d
+			return nil
+		}
+	}
+	s.deliveries = append(s.deliveries, *d)
+	return nil
+}
+
+// ListDeliveries retourne les dernières livraisons d'un abonnement, filtrées par statut si fourni
+func (s *MemoryStore) ListDeliveries(ctx context.Context, webhookID, status string, limit int) ([]webhook.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var deliveries []webhook.Delivery
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		d := s.deliveries[i]
+		if d.WebhookID != webhookID || (status != "" && d.Status != status) {
+			continue
+		}
+		deliveries = append(deliveries, d)
+		if limit > 0 && len(deliveries) == limit {
+			break
+		}
+	}
+
+	return deliveries, nil
+}
+
+// === TOKEN REPOSITORY ===
+
+// SaveToken enregistre l'empreinte d'un jeton de joueur
+func (s *MemoryStore) SaveToken(ctx context.Context, t *auth.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.tokens[t.ID]; exists {
+		return conflict("jeton déjà enregistré: %s", t.ID)
+	}
+	s.tokens[t.ID] = *t
+	return nil
+}
+
+// GetToken récupère un jeton par ID
+func (s *MemoryStore) GetToken(ctx context.Context, id string) (*auth.Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, exists := s.tokens[id]
+	if !exists {
+		return nil, tokenNotFound(id)
+	}
+	return &token, nil
+}
+
+// RevokeToken révoque un jeton (sans effet s'il l'est déjà)
+func (s *MemoryStore) RevokeToken(ctx context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, exists := s.tokens[id]
+	if !exists {
+		return notFound("jeton non trouvé: %s", id)
+	}
+	if token.RevokedAt == nil {
+		token.RevokedAt = &at
+		s.tokens[id] = token
+	}
+	return nil
+}
+
+// RevokePlayerTokens révoque tous les jetons actifs d'un joueur
+func (s *MemoryStore) RevokePlayerTokens(ctx context.Context, playerID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, token := range s.tokens {
+		if token.PlayerID == playerID && token.RevokedAt == nil {
+			token.RevokedAt = &at
+			s.tokens[id] = token
+		}
+	}
+	return nil
+}
+
+// === SAUVEGARDE ===
+
+// ListWords retourne le catalogue trié par ID
+func (s *MemoryStore) ListWords(ctx context.Context) ([]core.Word, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	words := make([]core.Word, 0, len(s.words))
+	for _, word := range s.words {
+		words = append(words, word)
+	}
+	sort.Slice(words, func(i, j int) bool { return words[i].ID < words[j].ID })
+	return words, nil
+}
+
+// ListCaptures retourne les captures datées d'un joueur
+func (s *MemoryStore) ListCaptures(ctx context.Context, playerID string) ([]Capture, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]Capture(nil), s.captures[playerID]...), nil
+}
+
+// ListTokens retourne les jetons d'un joueur par date de création
+func (s *MemoryStore) ListTokens(ctx context.Context, playerID string) ([]auth.Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var tokens []auth.Token
+	for _, token := range s.tokens {
+		if token.PlayerID == playerID {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].CreatedAt.Before(tokens[j].CreatedAt) })
+	return tokens, nil
+}
+
+// RestorePlayer insère un joueur tel quel ; l'ID est conservé s'il est libre
+func (s *MemoryStore) RestorePlayer(ctx context.Context, p core.Player) (string, error) {
+	if err := validateName(p.Name); err != nil {
+		return "", err
+	}
+	if err := validateXP(p.XP, p.Level); err != nil {
+		return "", err
+	}
+	if err := ValidateTeam(p.Team); err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, taken := s.names[p.Name]; taken {
+		return "", conflict("nom déjà pris: %s", p.Name)
+	}
+
+	id := s.freeID(p.ID)
+	// Les prochains IDs générés ne doivent pas rencontrer ceux restaurés
+	if digits, ok := strings.CutPrefix(id, "p"); ok {
+		if n, err := strconv.Atoi(digits); err == nil && n >= s.nextPlayerID {
+			s.nextPlayerID = n + 1
+		}
+	}
+
+	player := core.NewPlayer(id, p.Name)
+	player.XP, player.Level, player.Team = p.XP, p.Level, p.Team
+	s.players[id] = &player
+	s.names[p.Name] = id
+	for by, idx := range s.rankings {
+		idx.Set(id, 0)
+		if by == RankByXP {
+			idx.Set(id, p.XP)
+		}
+	}
+	return id, nil
 }
 
-// SetCurrentSpawn définit le WordMon actuel
-func (s *MemoryStore) SetCurrentSpawn(word *core.Word) {
+// RestoreCapture insère une capture datée sans créditer d'XP
+func (s *MemoryStore) RestoreCapture(ctx context.Context, playerID string, capture Capture) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.currentSpawn = word
+
+	player, exists := s.players[playerID]
+	if !exists {
+		return notFound("joueur non trouvé: %s", playerID)
+	}
+	word, exists := s.words[capture.WordID]
+	if !exists {
+		return notFound("mot non trouvé: %s", capture.WordID)
+	}
+
+	player.Inventory[word.ID]++
+	s.captures[playerID] = append(s.captures[playerID], capture)
+	s.indexCapture(player, word)
+	return nil
+}
+
+// === ÉTAT COMPLET ===
+
+// memoryState état persistable d'un MemoryStore (snapshots du FileStore)
+type memoryState struct {
+	NextPlayerID int                    `json:"nextPlayerId"`
+	Players      []memoryPlayer         `json:"players"`
+	Words        []core.Word            `json:"words"`
+	Retired      []string               `json:"retired,omitempty"`
+	Versions     []CatalogVersion       `json:"catalogVersions,omitempty"`
+	Catalog      *CatalogVersion        `json:"catalog,omitempty"` // ancien format : dernière version seule
+	Tokens       []auth.Token           `json:"tokens,omitempty"`
+	Webhooks     []webhook.Subscription `json:"webhooks,omitempty"`
+	Deliveries   []webhook.Delivery     `json:"deliveries,omitempty"` // dans l'ordre d'insertion
 }
 
-// GetCurrentSpawn retourne le WordMon actuel
-func (s *MemoryStore) GetCurrentSpawn() *core.Word {
+// memoryPlayer joueur et ses captures, dans l'ordre des captures
+type memoryPlayer struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	XP       int       `json:"xp"`
+	Level    int       `json:"level"`
+	Team     string    `json:"team,omitempty"`
+	Captures []Capture `json:"captures,omitempty"`
+}
+
+// state copie l'état complet du store (ordre stable des éléments)
+func (s *MemoryStore) state() memoryState {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.currentSpawn
+
+	state := memoryState{
+		NextPlayerID: s.nextPlayerID,
+		Versions:     append([]CatalogVersion(nil), s.versions...),
+		Deliveries:   append([]webhook.Delivery(nil), s.deliveries...),
+	}
+	for id, player := range s.players {
+		state.Players = append(state.Players, memoryPlayer{
+			ID:       id,
+			Name:     player.Name,
+			XP:       player.XP,
+			Level:    player.Level,
+			Team:     player.Team,
+			Captures: append([]Capture(nil), s.captures[id]...),
+		})
+	}
+	for _, word := range s.words {
+		state.Words = append(state.Words, word)
+	}
+	for id := range s.retired {
+		state.Retired = append(state.Retired, id)
+	}
+	for _, token := range s.tokens {
+		state.Tokens = append(state.Tokens, token)
+	}
+	for _, sub := range s.webhooks {
+		sub.Events = append([]string(nil), sub.Events...)
+		state.Webhooks = append(state.Webhooks, sub)
+	}
+
+	sort.Slice(state.Players, func(i, j int) bool { return state.Players[i].ID < state.Players[j].ID })
+	sort.Slice(state.Words, func(i, j int) bool { return state.Words[i].ID < state.Words[j].ID })
+	sort.Strings(state.Retired)
+	sort.Slice(state.Tokens, func(i, j int) bool { return state.Tokens[i].ID < state.Tokens[j].ID })
+	sort.Slice(state.Webhooks, func(i, j int) bool { return state.Webhooks[i].ID < state.Webhooks[j].ID })
+	return state
 }
 
-// ClearCurrentSpawn supprime le WordMon actuel
-func (s *MemoryStore) ClearCurrentSpawn() {
+// load remplace tout le contenu du store par state et reconstruit les index
+func (s *MemoryStore) load(state memoryState) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.currentSpawn = nil
+
+	s.players = make(map[string]*core.Player, len(state.Players))
+	s.names = make(map[string]string, len(state.Players))
+	s.words = make(map[string]core.Word, len(state.Words))
+	s.retired = make(map[string]bool, len(state.Retired))
+	s.versions = append([]CatalogVersion(nil), state.Versions...)
+	if len(s.versions) == 0 && state.Catalog != nil {
+		s.versions = []CatalogVersion{*state.Catalog}
+	}
+	s.captures = make(map[string][]Capture, len(state.Players))
+	s.tokens = make(map[string]auth.Token, len(state.Tokens))
+	s.webhooks = make(map[string]webhook.Subscription, len(state.Webhooks))
+	s.deliveries = append([]webhook.Delivery(nil), state.Deliveries...)
+	for by := range s.rankings {
+		s.rankings[by] = newRankIndex()
+	}
+	s.nextPlayerID = max(state.NextPlayerID, 1)
+
+	for _, word := range state.Words {
+		s.words[word.ID] = word
+	}
+	for _, id := range state.Retired {
+		s.retired[id] = true
+	}
+	for _, p := range state.Players {
+		if _, taken := s.names[p.Name]; taken {
+			return conflict("nom en double dans l'état chargé: %s", p.Name)
+		}
+		player := core.NewPlayer(p.ID, p.Name)
+		player.XP, player.Level, player.Team = p.XP, p.Level, p.Team
+
+		captures := append([]Capture(nil), p.Captures...)
+		legendary := 0
+		for _, capture := range captures {
+			word, exists := s.words[capture.WordID]
+			if !exists {
+				return notFound("mot capturé absent de l'état chargé: %s", capture.WordID)
+			}
+			player.Inventory[capture.WordID]++
+			if word.Rarity == core.Legendary {
+				legendary++
+			}
+		}
+
+		s.players[p.ID] = &player
+		s.names[p.Name] = p.ID
+		s.captures[p.ID] = captures
+		s.rankings[RankByXP].Set(p.ID, p.XP)
+		s.rankings[RankByCaptures].Set(p.ID, len(captures))
+		s.rankings[RankByLegendary].Set(p.ID, legendary)
+		s.rankings[RankByDistinct].Set(p.ID, len(player.Inventory))
+	}
+	for _, token := range state.Tokens {
+		s.tokens[token.ID] = token
+	}
+	for _, sub := range state.Webhooks {
+		s.webhooks[sub.ID] = sub
+	}
+	return nil
 }
 
-// GetActivePlayersCount retourne le nombre de joueurs actifs
-func (s *MemoryStore) GetActivePlayersCount() int {
+// nextID retourne l'ID que recevra le prochain joueur créé
+func (s *MemoryStore) nextID() string {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return len(s.players)
+	return fmt.Sprintf("p%d", s.nextPlayerID)
 }
 
-// GetUptimeSeconds retourne le temps depuis le démarrage
-func (s *MemoryStore) GetUptimeSeconds() int {
-	return int(time.Since(s.startTime).Seconds())
+// restoreID retourne l'ID qu'obtiendrait un joueur restauré sous id
+func (s *MemoryStore) restoreID(id string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.freeID(id)
+}
+
+// freeID conserve id s'il est libre, sinon retourne le prochain ID généré
+// (verrou requis)
+func (s *MemoryStore) freeID(id string) string {
+	if _, exists := s.players[id]; exists || id == "" {
+		return fmt.Sprintf("p%d", s.nextPlayerID)
+	}
+	return id
+}
+
+// nameTaken indique si un joueur porte déjà ce nom
+func (s *MemoryStore) nameTaken(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, taken := s.names[name]
+	return taken
+}
+
+// hasPlayer indique si le joueur existe
+func (s *MemoryStore) hasPlayer(id string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, exists := s.players[id]
+	return exists
+}
+
+// missingWords retourne les mots absents du catalogue
+func (s *MemoryStore) missingWords(words []core.Word) []core.Word {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var missing []core.Word
+	for _, word := range words {
+		if _, exists := s.words[word.ID]; !exists {
+			missing = append(missing, word)
+		}
+	}
+	return missing
 }
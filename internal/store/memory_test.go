@@ -0,0 +1,116 @@
+package store
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+func TestMemoryStoreCopiesOnRead(t *testing.T) {
+	ctx := t.Context()
+	s := NewMemoryStore()
+	s.Seed(ctx, []core.Word{{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}})
+	alice, _ := s.Create(ctx, "Alice")
+	s.Add(ctx, alice.ID, "c_1")
+
+	// Modifier une copie ne touche pas le store
+	player, _ := s.Get(ctx, alice.ID)
+	player.XP = 1000
+	player.Inventory["c_1"] = 42
+	word, _ := s.GetWord(ctx, "c_1")
+	word.Points = 1000
+
+	stored, _ := s.Get(ctx, alice.ID)
+	if stored.XP != 5 || stored.Inventory["c_1"] != 1 {
+		t.Errorf("le joueur du store a été modifié via une copie: %+v", stored)
+	}
+	if w, _ := s.GetWord(ctx, "c_1"); w.Points != 5 {
+		t.Errorf("le mot du store a été modifié via une copie: %+v", w)
+	}
+}
+
+func TestMemoryStoreAddIsAtomic(t *testing.T) {
+	ctx := t.Context()
+	s := NewMemoryStore()
+	s.Seed(ctx, []core.Word{{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}})
+	alice, _ := s.Create(ctx, "Alice")
+
+	const goroutines, captures = 8, 100
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < captures; i++ {
+				if err := s.Add(ctx, alice.ID, "c_1"); err != nil {
+					t.Errorf("capture: %v", err)
+				}
+				s.Get(ctx, alice.ID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Capture et XP toujours ensemble : aucune mise à jour perdue
+	player, _ := s.Get(ctx, alice.ID)
+	words, _ := s.ListByPlayer(ctx, alice.ID)
+	total := goroutines * captures
+	if player.XP != 5*total || player.Inventory["c_1"] != total || len(words) != total {
+		t.Errorf("attendu %d captures et %d XP, obtenu %d/%d (XP %d)", total, 5*total, player.Inventory["c_1"], len(words), player.XP)
+	}
+}
+
+func TestMemoryStoreFreesNameOnDelete(t *testing.T) {
+	ctx := t.Context()
+	s := NewMemoryStore()
+	alice, _ := s.Create(ctx, "Alice")
+
+	if err := s.Delete(ctx, alice.ID); err != nil {
+		t.Fatalf("suppression: %v", err)
+	}
+	if _, err := s.Create(ctx, "Alice"); err != nil {
+		t.Errorf("le nom d'un joueur supprimé doit être libre: %v", err)
+	}
+}
+
+func TestMemoryStoreAddXPKeepsConcurrentCaptures(t *testing.T) {
+	ctx := t.Context()
+	s := NewMemoryStore()
+	s.Seed(ctx, []core.Word{{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}})
+	alice, _ := s.Create(ctx, "Alice")
+	s.UpdateXP(ctx, alice.ID, 1000, core.LevelFromXP(1000))
+
+	// Captures (+5) et pénalités (-2) entrelacées : aucune ne doit être perdue
+	const rounds = 200
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for range rounds {
+			s.Add(ctx, alice.ID, "c_1")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range rounds {
+			if applied, err := s.AddXP(ctx, alice.ID, -2); err != nil || applied != -2 {
+				t.Errorf("pénalité: %d (%v)", applied, err)
+			}
+		}
+	}()
+	wg.Wait()
+
+	player, _ := s.Get(ctx, alice.ID)
+	if want := 1000 + 3*rounds; player.XP != want || player.Level != core.LevelFromXP(want) {
+		t.Errorf("XP %d niveau %d attendus, obtenu %d niveau %d", want, core.LevelFromXP(want), player.XP, player.Level)
+	}
+
+	// Plancher à zéro : seule l'XP restante est retirée
+	if applied, _ := s.AddXP(ctx, alice.ID, -5000); applied != -player.XP {
+		t.Errorf("retrait de %d attendu, obtenu %d", player.XP, applied)
+	}
+	if player, _ := s.Get(ctx, alice.ID); player.XP != 0 || player.Level != 1 {
+		t.Errorf("XP 0 niveau 1 attendus, obtenu %+v", player)
+	}
+}
@@ -0,0 +1,270 @@
+package store
+
+import "math/rand"
+
+// Hauteur maximale de la skip list : suffisant pour des millions de joueurs
+// avec une probabilité de promotion de 1/4
+const (
+	rankMaxLevel    = 24
+	rankPromoteRate = 4
+)
+
+// rankIndex index ordonné des joueurs (score décroissant puis ID) pour un
+// critère de classement. C'est une skip list indexable : chaque lien connaît
+// le nombre d'éléments qu'il saute, ce qui donne le top N, la position d'un
+// joueur et ses voisins en O(log n). Les scores distincts sont indexés à part
+// pour les rangs denses. Non synchronisé : protégé par le verrou du store.
+type rankIndex struct {
+	players *skipList
+	scores  map[string]int // ID -> score indexé
+	counts  map[int]int    // score -> nombre de joueurs
+	levels  *skipList      // un élément (score, "") par score distinct
+}
+
+// newRankIndex crée un index vide
+func newRankIndex() *rankIndex {
+	return &rankIndex{
+		players: newSkipList(),
+		scores:  make(map[string]int),
+		counts:  make(map[int]int),
+		levels:  newSkipList(),
+	}
+}
+
+// Len retourne le nombre de joueurs indexés
+func (idx *rankIndex) Len() int {
+	return idx.players.length
+}
+
+// Set indexe le joueur avec son nouveau score (insertion ou déplacement)
+func (idx *rankIndex) Set(id string, score int) {
+	if old, exists := idx.scores[id]; exists {
+		if old == score {
+			return
+		}
+		idx.Remove(id)
+	}
+
+	idx.players.insert(score, id)
+	idx.scores[id] = score
+	if idx.counts[score] == 0 {
+		idx.levels.insert(score, "")
+	}
+	idx.counts[score]++
+}
+
+// Add ajoute delta au score du joueur
+func (idx *rankIndex) Add(id string, delta int) {
+	idx.Set(id, idx.scores[id]+delta)
+}
+
+// Remove retire le joueur de l'index
+func (idx *rankIndex) Remove(id string) {
+	score, exists := idx.scores[id]
+	if !exists {
+		return
+	}
+
+	idx.players.remove(score, id)
+	delete(idx.scores, id)
+	idx.counts[score]--
+	if idx.counts[score] == 0 {
+		delete(idx.counts, score)
+		idx.levels.remove(score, "")
+	}
+}
+
+// Score retourne le score indexé du joueur
+func (idx *rankIndex) Score(id string) (int, bool) {
+	score, exists := idx.scores[id]
+	return score, exists
+}
+
+// Position retourne la position (0 en tête) du joueur
+func (idx *rankIndex) Position(id string) (int, bool) {
+	score, exists := idx.scores[id]
+	if !exists {
+		return 0, false
+	}
+	return idx.players.countBefore(score, id, false), true
+}
+
+// PositionAfter retourne la position du premier joueur classé après le curseur
+func (idx *rankIndex) PositionAfter(cursor Cursor) int {
+	return idx.players.countBefore(cursor.Score, cursor.ID, true)
+}
+
+// DenseRank retourne le rang dense d'un score (1 pour le meilleur score)
+func (idx *rankIndex) DenseRank(score int) int {
+	return idx.levels.countBefore(score, "", false) + 1
+}
+
+// NotAbove retourne le nombre de joueurs dont le score est inférieur ou égal
+func (idx *rankIndex) NotAbove(score int) int {
+	return idx.players.length - idx.players.countBefore(score, "", false)
+}
+
+// Range appelle fn pour les joueurs à partir de la position start, dans
+// l'ordre du classement, tant que fn retourne true
+func (idx *rankIndex) Range(start int, fn func(id string, score int) bool) {
+	for node := idx.players.at(start); node != nil; node = node.next[0].node {
+		if !fn(node.id, node.score) {
+			return
+		}
+	}
+}
+
+// === SKIP LIST ===
+
+// skipList liste ordonnée de couples (score, id), score décroissant puis id croissant
+type skipList struct {
+	head   *skipNode
+	level  int
+	length int
+	rng    *rand.Rand
+}
+
+type skipNode struct {
+	score int
+	id    string
+	next  []skipLink
+}
+
+// skipLink lien vers le nœud suivant d'un niveau ; span compte les
+// éléments franchis (le nœud d'arrivée compris)
+type skipLink struct {
+	node *skipNode
+	span int
+}
+
+func newSkipList() *skipList {
+	return &skipList{
+		head:  &skipNode{next: make([]skipLink, rankMaxLevel)},
+		level: 1,
+		rng:   rand.New(rand.NewSource(1)),
+	}
+}
+
+// before indique si (score, id) est classé avant (otherScore, otherID)
+func before(score int, id string, otherScore int, otherID string) bool {
+	return score > otherScore || (score == otherScore && id < otherID)
+}
+
+// randomLevel tire la hauteur d'un nouveau nœud
+func (l *skipList) randomLevel() int {
+	level := 1
+	for level < rankMaxLevel && l.rng.Intn(rankPromoteRate) == 0 {
+		level++
+	}
+	return level
+}
+
+// insert ajoute (score, id) ; le couple ne doit pas déjà être présent
+func (l *skipList) insert(score int, id string) {
+	var update [rankMaxLevel]*skipNode
+	var rank [rankMaxLevel]int
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for next := x.next[i].node; next != nil && before(next.score, next.id, score, id); next = x.next[i].node {
+			rank[i] += x.next[i].span
+			x = next
+		}
+		update[i] = x
+	}
+
+	level := l.randomLevel()
+	if level > l.level {
+		for i := l.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = l.head
+			update[i].next[i].span = l.length
+		}
+		l.level = level
+	}
+
+	node := &skipNode{score: score, id: id, next: make([]skipLink, level)}
+	for i := 0; i < level; i++ {
+		node.next[i].node = update[i].next[i].node
+		update[i].next[i].node = node
+
+		// Le lien précédent est coupé en deux au niveau du nouveau nœud
+		node.next[i].span = update[i].next[i].span - (rank[0] - rank[i])
+		update[i].next[i].span = rank[0] - rank[i] + 1
+	}
+	for i := level; i < l.level; i++ {
+		update[i].next[i].span++
+	}
+	l.length++
+}
+
+// remove retire (score, id) s'il est présent
+func (l *skipList) remove(score int, id string) {
+	var update [rankMaxLevel]*skipNode
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for next := x.next[i].node; next != nil && before(next.score, next.id, score, id); next = x.next[i].node {
+			x = next
+		}
+		update[i] = x
+	}
+
+	target := x.next[0].node
+	if target == nil || target.score != score || target.id != id {
+		return
+	}
+
+	for i := 0; i < l.level; i++ {
+		if update[i].next[i].node == target {
+			update[i].next[i].span += target.next[i].span - 1
+			update[i].next[i].node = target.next[i].node
+		} else {
+			update[i].next[i].span--
+		}
+	}
+	for l.level > 1 && l.head.next[l.level-1].node == nil {
+		l.level--
+	}
+	l.length--
+}
+
+// countBefore compte les éléments classés avant (score, id), ou
+// avant ou égaux si inclusive
+func (l *skipList) countBefore(score int, id string, inclusive bool) int {
+	count := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for next := x.next[i].node; next != nil; next = x.next[i].node {
+			if !before(next.score, next.id, score, id) && !(inclusive && next.score == score && next.id == id) {
+				break
+			}
+			count += x.next[i].span
+			x = next
+		}
+	}
+	return count
+}
+
+// at retourne le nœud de la position pos (0 en tête), nil au-delà de la fin
+func (l *skipList) at(pos int) *skipNode {
+	if pos < 0 || pos >= l.length {
+		return nil
+	}
+
+	traversed := 0
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && traversed+x.next[i].span <= pos+1 {
+			traversed += x.next[i].span
+			x = x.next[i].node
+		}
+		if traversed == pos+1 {
+			return x
+		}
+	}
+	return nil
+}
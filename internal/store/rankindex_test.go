@@ -0,0 +1,135 @@
+package store
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// naiveRanking classement de référence : tri complet
+func naiveRanking(scores map[string]int) []RankedPlayer {
+	players := make([]RankedPlayer, 0, len(scores))
+	for id, score := range scores {
+		players = append(players, RankedPlayer{Score: score})
+		players[len(players)-1].ID = id
+	}
+	sort.Slice(players, func(i, j int) bool {
+		return before(players[i].Score, players[i].ID, players[j].Score, players[j].ID)
+	})
+	denseRanks(players)
+	return players
+}
+
+func TestRankIndexMatchesFullSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	idx := newRankIndex()
+	scores := map[string]int{}
+
+	for step := 0; step < 5000; step++ {
+		id := fmt.Sprintf("p%d", rng.Intn(300))
+		switch rng.Intn(4) {
+		case 0:
+			idx.Remove(id)
+			delete(scores, id)
+		default:
+			// Peu de scores différents : beaucoup d'ex aequo
+			score := rng.Intn(50)
+			idx.Set(id, score)
+			scores[id] = score
+		}
+	}
+
+	want := naiveRanking(scores)
+	if idx.Len() != len(want) {
+		t.Fatalf("taille: %d, attendu %d", idx.Len(), len(want))
+	}
+
+	i := 0
+	idx.Range(0, func(id string, score int) bool {
+		if id != want[i].ID || score != want[i].Score {
+			t.Fatalf("position %d: %s/%d, attendu %s/%d", i, id, score, want[i].ID, want[i].Score)
+		}
+		i++
+		return true
+	})
+
+	for pos, player := range want {
+		if got, _ := idx.Position(player.ID); got != pos {
+			t.Errorf("%s: position %d, attendu %d", player.ID, got, pos)
+		}
+		if got := idx.DenseRank(player.Score); got != player.Rank {
+			t.Errorf("%s: rang %d, attendu %d", player.ID, got, player.Rank)
+		}
+		if got := idx.PositionAfter(Cursor{Score: player.Score, ID: player.ID}); got != pos+1 {
+			t.Errorf("%s: après le curseur %d, attendu %d", player.ID, got, pos+1)
+		}
+		if got, want := idx.NotAbove(player.Score), len(want)-first(want, pos); got != want {
+			t.Errorf("%s: %d joueurs au score inférieur ou égal, attendu %d", player.ID, got, want)
+		}
+	}
+}
+
+// buildRankIndex indexe n joueurs aux scores aléatoires
+func buildRankIndex(n int) (*rankIndex, []string) {
+	rng := rand.New(rand.NewSource(1))
+	idx := newRankIndex()
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("p%d", i)
+		idx.Set(ids[i], rng.Intn(n))
+	}
+	return idx, ids
+}
+
+var rankIndexSizes = []int{1_000, 10_000, 100_000, 1_000_000}
+
+func BenchmarkRankIndexUpdate(b *testing.B) {
+	for _, n := range rankIndexSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			idx, ids := buildRankIndex(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				idx.Add(ids[i%n], 5)
+			}
+		})
+	}
+}
+
+func BenchmarkRankIndexTop10(b *testing.B) {
+	for _, n := range rankIndexSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			idx, _ := buildRankIndex(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				count := 0
+				idx.Range(0, func(string, int) bool {
+					count++
+					return count < 10
+				})
+			}
+		})
+	}
+}
+
+func BenchmarkRankIndexPlayerRank(b *testing.B) {
+	for _, n := range rankIndexSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			idx, ids := buildRankIndex(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				id := ids[(i*7919)%n]
+				pos, _ := idx.Position(id)
+				score, _ := idx.Score(id)
+				idx.DenseRank(score)
+				idx.NotAbove(score)
+				// Voisins : un joueur de part et d'autre
+				count := 0
+				idx.Range(max(0, pos-1), func(string, int) bool {
+					count++
+					return count < 3
+				})
+			}
+		})
+	}
+}
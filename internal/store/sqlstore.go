@@ -1,55 +1,129 @@
 package store
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
+	"errors"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/SamG1008/wordmon-go/internal/auth"
 	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/migrate"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
 	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 )
 
 // SQLStore implémente la persistance avec PostgreSQL
 type SQLStore struct {
-	db *sql.DB
+	db       *sql.DB
+	bus      *core.EventBus
+	timeouts Timeouts
+}
+
+// Timeouts délais maximum d'une opération d'un store SQL, en plus de
+// l'échéance du contexte de l'appelant (0 : aucun)
+type Timeouts struct {
+	Read  time.Duration
+	Write time.Duration
+}
+
+// read borne ctx au délai des lectures
+func (t Timeouts) read(ctx context.Context) (context.Context, context.CancelFunc) {
+	return withTimeout(ctx, t.Read)
+}
+
+// write borne ctx au délai des écritures
+func (t Timeouts) write(ctx context.Context) (context.Context, context.CancelFunc) {
+	return withTimeout(ctx, t.Write)
+}
+
+// withTimeout applique le délai d s'il est défini
+func withTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d)
 }
 
 // NewSQLStore crée un nouveau store SQL
 func NewSQLStore(databaseURL string) (*SQLStore, error) {
 	db, err := sql.Open("postgres", databaseURL)
 	if err != nil {
-		return nil, fmt.Errorf("erreur connexion DB: %w", err)
+		return nil, wrapErr(err, "erreur connexion DB")
 	}
 
 	// Test de la connexion
 	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("erreur ping DB: %w", err)
+		return nil, wrapErr(err, "erreur ping DB")
 	}
 
 	log.Printf("[db] Connected to Postgres (wordmon)")
 
+	if err := migrateSchema(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	return &SQLStore{db: db}, nil
 }
 
+// migrateSchema applique les migrations SQL en attente (partagé avec GORMStore)
+func migrateSchema(db *sql.DB) error {
+	migrator, err := migrate.Default(db)
+	if err != nil {
+		return err
+	}
+	count, err := migrator.Up(context.Background())
+	if err != nil {
+		return wrapErr(err, "erreur migration du schéma")
+	}
+	log.Printf("[migrate] schéma en version %d (%d migration(s) appliquée(s))", migrator.Latest(), count)
+	return nil
+}
+
 // Close ferme la connexion à la base
 func (s *SQLStore) Close() error {
 	return s.db.Close()
 }
 
+// DB retourne la connexion SQL sous-jacente (partagée avec d'autres services)
+func (s *SQLStore) DB() *sql.DB {
+	return s.db
+}
+
+// SetEventBus définit le bus sur lequel le store publie ses événements
+func (s *SQLStore) SetEventBus(bus *core.EventBus) {
+	s.bus = bus
+}
+
+// SetTimeouts définit les délais des lectures et des écritures
+func (s *SQLStore) SetTimeouts(timeouts Timeouts) {
+	s.timeouts = timeouts
+}
+
 // === PLAYER REPOSITORY ===
 
 // Create crée un nouveau joueur
-func (s *SQLStore) Create(name string) (*core.Player, error) {
+func (s *SQLStore) Create(ctx context.Context, name string) (*core.Player, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
 	id := uuid.New().String()
 
 	query := `INSERT INTO players (id, name, xp, level) VALUES ($1, $2, 0, 1)`
-	_, err := s.db.Exec(query, id, name)
+	_, err := s.db.ExecContext(ctx, query, id, name)
 	if err != nil {
-		return nil, fmt.Errorf("erreur création joueur: %w", err)
+		return nil, wrapErr(err, "erreur création joueur")
 	}
 
-	log.Printf("[api] Player created: %s (id=%s)", name, id)
+	s.bus.Publish(core.PlayerCreated{PlayerID: id, Name: name, At: time.Now().UTC()})
 
 	return &core.Player{
 		ID:    id,
@@ -60,22 +134,25 @@ func (s *SQLStore) Create(name string) (*core.Player, error) {
 }
 
 // Get récupère un joueur par ID
-func (s *SQLStore) Get(id string) (*core.Player, error) {
+func (s *SQLStore) Get(ctx context.Context, id string) (*core.Player, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
 	player := &core.Player{}
-	query := `SELECT id, name, xp, level FROM players WHERE id = $1`
+	query := `SELECT id, name, xp, level, team FROM players WHERE id = $1`
 
-	err := s.db.QueryRow(query, id).Scan(&player.ID, &player.Name, &player.XP, &player.Level)
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&player.ID, &player.Name, &player.XP, &player.Level, &player.Team)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("joueur non trouvé: %s", id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, notFound("joueur non trouvé: %s", id)
 		}
-		return nil, fmt.Errorf("erreur récupération joueur: %w", err)
+		return nil, wrapErr(err, "erreur récupération joueur")
 	}
 
 	// Récupération de l'inventaire (captures)
-	inventory, err := s.getPlayerInventory(id)
+	inventory, err := s.getPlayerInventory(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("erreur récupération inventaire: %w", err)
+		return nil, wrapErr(err, "erreur récupération inventaire")
 	}
 	player.Inventory = inventory
 
@@ -83,7 +160,7 @@ func (s *SQLStore) Get(id string) (*core.Player, error) {
 }
 
 // getPlayerInventory récupère l'inventaire d'un joueur
-func (s *SQLStore) getPlayerInventory(playerID string) (map[string]int, error) {
+func (s *SQLStore) getPlayerInventory(ctx context.Context, playerID string) (map[string]int, error) {
 	inventory := make(map[string]int)
 
 	query := `
@@ -93,9 +170,9 @@ func (s *SQLStore) getPlayerInventory(playerID string) (map[string]int, error) {
 		WHERE c.player_id = $1 
 		GROUP BY w.id`
 
-	rows, err := s.db.Query(query, playerID)
+	rows, err := s.db.QueryContext(ctx, query, playerID)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err, "erreur récupération inventaire")
 	}
 	defer rows.Close()
 
@@ -103,45 +180,137 @@ func (s *SQLStore) getPlayerInventory(playerID string) (map[string]int, error) {
 		var wordID string
 		var count int
 		if err := rows.Scan(&wordID, &count); err != nil {
-			return nil, err
+			return nil, wrapErr(err, "erreur lecture inventaire")
 		}
 		inventory[wordID] = count
 	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture inventaire")
+	}
 
 	return inventory, nil
 }
 
 // List récupère la liste des joueurs (pour le leaderboard)
-func (s *SQLStore) List(limit int) ([]core.Player, error) {
-	query := `SELECT id, name, xp, level FROM players ORDER BY xp DESC`
-	if limit > 0 {
-		query += fmt.Sprintf(" LIMIT %d", limit)
+func (s *SQLStore) List(ctx context.Context, limit int) ([]core.Player, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	// LIMIT NULL : pas de limite
+	query := `SELECT id, name, xp, level, team FROM players ORDER BY xp DESC, id LIMIT NULLIF($1, 0)`
+	if limit < 0 {
+		limit = 0
 	}
 
-	rows, err := s.db.Query(query)
+	rows, err := s.db.QueryContext(ctx, query, limit)
 	if err != nil {
-		return nil, fmt.Errorf("erreur récupération leaderboard: %w", err)
+		return nil, wrapErr(err, "erreur récupération leaderboard")
 	}
 	defer rows.Close()
 
 	var players []core.Player
 	for rows.Next() {
 		var player core.Player
-		if err := rows.Scan(&player.ID, &player.Name, &player.XP, &player.Level); err != nil {
-			return nil, err
+		if err := rows.Scan(&player.ID, &player.Name, &player.XP, &player.Level, &player.Team); err != nil {
+			return nil, wrapErr(err, "erreur lecture joueur")
 		}
 		players = append(players, player)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture joueurs")
+	}
 
 	return players, nil
 }
 
+// SetTeam change l'équipe d'un joueur ("" : aucune)
+func (s *SQLStore) SetTeam(ctx context.Context, id string, team string) error {
+	if err := ValidateTeam(team); err != nil {
+		return err
+	}
+
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	result, err := s.db.ExecContext(ctx, `UPDATE players SET team = $1 WHERE id = $2`, team, id)
+	if err != nil {
+		return wrapErr(err, "erreur changement d'équipe")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return notFound("joueur non trouvé: %s", id)
+	}
+	return nil
+}
+
+// Leaderboard retourne une page du classement filtré
+func (s *SQLStore) Leaderboard(ctx context.Context, q LeaderboardQuery) (*LeaderboardPage, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	return sqlLeaderboard(ctx, s.db, q)
+}
+
+// PlayerRank retourne la place d'un joueur et ses voisins dans le classement filtré
+func (s *SQLStore) PlayerRank(ctx context.Context, id string, q LeaderboardQuery, neighbors int) (*PlayerRank, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	return sqlPlayerRank(ctx, s.db, id, q, neighbors)
+}
+
 // UpdateXP met à jour l'XP et le niveau d'un joueur
-func (s *SQLStore) UpdateXP(id string, newXP int, newLevel int) error {
+func (s *SQLStore) UpdateXP(ctx context.Context, id string, newXP int, newLevel int) error {
+	if err := validateXP(newXP, newLevel); err != nil {
+		return err
+	}
+
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
 	query := `UPDATE players SET xp = $1, level = $2 WHERE id = $3`
-	_, err := s.db.Exec(query, newXP, newLevel, id)
+	result, err := s.db.ExecContext(ctx, query, newXP, newLevel, id)
 	if err != nil {
-		return fmt.Errorf("erreur mise à jour XP: %w", err)
+		return wrapErr(err, "erreur mise à jour XP")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return notFound("joueur non trouvé: %s", id)
+	}
+	return nil
+}
+
+// AddXP ajoute delta à l'XP d'un joueur (plancher à 0) et recalcule son
+// niveau en une requête ; la ligne verrouillée donne l'XP d'avant
+func (s *SQLStore) AddXP(ctx context.Context, id string, delta int) (int, error) {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	query := `
+		WITH old AS (SELECT id, xp FROM players WHERE id = $2 FOR UPDATE)
+		UPDATE players p SET xp = GREATEST(old.xp + $1, 0), level = GREATEST(old.xp + $1, 0) / 100 + 1
+		FROM old WHERE p.id = old.id
+		RETURNING p.xp - old.xp`
+	var applied int
+	err := s.db.QueryRowContext(ctx, query, delta, id).Scan(&applied)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, notFound("joueur non trouvé: %s", id)
+	}
+	if err != nil {
+		return 0, wrapErr(err, "erreur mise à jour XP")
+	}
+	return applied, nil
+}
+
+// Delete supprime un joueur ; ses captures et jetons suivent (ON DELETE CASCADE)
+func (s *SQLStore) Delete(ctx context.Context, id string) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	result, err := s.db.ExecContext(ctx, `DELETE FROM players WHERE id = $1`, id)
+	if err != nil {
+		return wrapErr(err, "erreur suppression joueur")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return notFound("joueur non trouvé: %s", id)
 	}
 	return nil
 }
@@ -149,12 +318,15 @@ func (s *SQLStore) UpdateXP(id string, newXP int, newLevel int) error {
 // === WORD REPOSITORY ===
 
 // Seed insère les mots depuis la configuration
-func (s *SQLStore) Seed(words []core.Word) error {
+func (s *SQLStore) Seed(ctx context.Context, words []core.Word) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
 	// Vérifier si les mots existent déjà
 	var count int
-	err := s.db.QueryRow("SELECT COUNT(*) FROM words").Scan(&count)
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM words").Scan(&count)
 	if err != nil {
-		return fmt.Errorf("erreur vérification seed: %w", err)
+		return wrapErr(err, "erreur vérification seed")
 	}
 
 	if count > 0 {
@@ -163,22 +335,22 @@ func (s *SQLStore) Seed(words []core.Word) error {
 	}
 
 	// Insérer les mots
-	tx, err := s.db.Begin()
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("erreur transaction seed: %w", err)
+		return wrapErr(err, "erreur transaction seed")
 	}
 	defer tx.Rollback()
 
 	query := `INSERT INTO words (id, text, rarity, points) VALUES ($1, $2, $3, $4)`
 	for _, word := range words {
-		_, err := tx.Exec(query, word.ID, word.Text, string(word.Rarity), word.Points)
+		_, err := tx.ExecContext(ctx, query, word.ID, word.Text, string(word.Rarity), word.Points)
 		if err != nil {
-			return fmt.Errorf("erreur insertion mot %s: %w", word.ID, err)
+			return wrapErr(err, "erreur insertion mot %s", word.ID)
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("erreur commit seed: %w", err)
+		return wrapErr(err, "erreur commit seed")
 	}
 
 	log.Printf("[seed] %d words loaded into DB", len(words))
@@ -186,83 +358,106 @@ func (s *SQLStore) Seed(words []core.Word) error {
 }
 
 // GetWord récupère un mot par ID
-func (s *SQLStore) GetWord(id string) (*core.Word, error) {
+func (s *SQLStore) GetWord(ctx context.Context, id string) (*core.Word, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
 	word := &core.Word{}
 	query := `SELECT id, text, rarity, points FROM words WHERE id = $1`
 
 	var rarityStr string
-	err := s.db.QueryRow(query, id).Scan(&word.ID, &word.Text, &rarityStr, &word.Points)
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&word.ID, &word.Text, &rarityStr, &word.Points)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("mot non trouvé: %s", id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, notFound("mot non trouvé: %s", id)
 		}
-		return nil, fmt.Errorf("erreur récupération mot: %w", err)
+		return nil, wrapErr(err, "erreur récupération mot")
 	}
 
 	word.Rarity = core.Rarity(rarityStr)
 	return word, nil
 }
 
-// RandomByRarity sélectionne un mot aléatoire par rareté
-func (s *SQLStore) RandomByRarity(rarity string) (*core.Word, error) {
+// RandomByRarity sélectionne un mot aléatoire par rareté, hors mots retirés
+func (s *SQLStore) RandomByRarity(ctx context.Context, rarity string) (*core.Word, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
 	word := &core.Word{}
-	query := `SELECT id, text, rarity, points FROM words WHERE rarity = $1 ORDER BY RANDOM() LIMIT 1`
+	query := `SELECT id, text, rarity, points FROM words WHERE rarity = $1 AND retired_at IS NULL ORDER BY RANDOM() LIMIT 1`
 
 	var rarityStr string
-	err := s.db.QueryRow(query, rarity).Scan(&word.ID, &word.Text, &rarityStr, &word.Points)
+	err := s.db.QueryRowContext(ctx, query, rarity).Scan(&word.ID, &word.Text, &rarityStr, &word.Points)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("aucun mot trouvé pour rareté: %s", rarity)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, notFound("aucun mot trouvé pour rareté: %s", rarity)
 		}
-		return nil, fmt.Errorf("erreur sélection mot aléatoire: %w", err)
+		return nil, wrapErr(err, "erreur sélection mot aléatoire")
 	}
 
 	word.Rarity = core.Rarity(rarityStr)
 	return word, nil
 }
 
+// SyncWords aligne le catalogue en base sur la config (voir CatalogStore)
+func (s *SQLStore) SyncWords(ctx context.Context, words []core.Word, apply bool) (*CatalogSync, error) {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+	return sqlSyncWords(ctx, s.db, words, apply)
+}
+
 // === CAPTURE REPOSITORY ===
 
 // Add enregistre une capture (avec transaction)
-func (s *SQLStore) Add(playerID, wordID string) error {
-	tx, err := s.db.Begin()
+func (s *SQLStore) Add(ctx context.Context, playerID, wordID string) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("erreur transaction capture: %w", err)
+		return wrapErr(err, "erreur transaction capture")
 	}
 	defer tx.Rollback()
 
 	// 1. Insérer la capture
 	captureID := uuid.New().String()
 	captureQuery := `INSERT INTO captures (id, player_id, word_id) VALUES ($1, $2, $3)`
-	_, err = tx.Exec(captureQuery, captureID, playerID, wordID)
+	_, err = tx.ExecContext(ctx, captureQuery, captureID, playerID, wordID)
 	if err != nil {
-		return fmt.Errorf("erreur insertion capture: %w", err)
+		return wrapErr(err, "erreur insertion capture")
 	}
 
-	// 2. Récupérer les points du mot
-	var points int
-	pointsQuery := `SELECT points FROM words WHERE id = $1`
-	err = tx.QueryRow(pointsQuery, wordID).Scan(&points)
+	// 2. Récupérer le mot et ses points
+	word := core.Word{ID: wordID}
+	var rarityStr string
+	wordQuery := `SELECT text, rarity, points FROM words WHERE id = $1`
+	err = tx.QueryRowContext(ctx, wordQuery, wordID).Scan(&word.Text, &rarityStr, &word.Points)
 	if err != nil {
-		return fmt.Errorf("erreur récupération points: %w", err)
+		return wrapErr(err, "erreur récupération points")
 	}
+	word.Rarity = core.Rarity(rarityStr)
 
 	// 3. Mettre à jour l'XP du joueur
-	updateQuery := `UPDATE players SET xp = xp + $1, level = (xp + $1) / 100 + 1 WHERE id = $2`
-	_, err = tx.Exec(updateQuery, points, playerID)
+	player := core.Player{ID: playerID}
+	updateQuery := `UPDATE players SET xp = xp + $1, level = (xp + $1) / 100 + 1 WHERE id = $2 RETURNING name, xp, level`
+	err = tx.QueryRowContext(ctx, updateQuery, word.Points, playerID).Scan(&player.Name, &player.XP, &player.Level)
 	if err != nil {
-		return fmt.Errorf("erreur mise à jour XP: %w", err)
+		return wrapErr(err, "erreur mise à jour XP")
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("erreur commit capture: %w", err)
+		return wrapErr(err, "erreur commit capture")
 	}
 
+	s.bus.Publish(core.CaptureEvents(player, word, core.LevelFromXP(player.XP-word.Points))...)
 	return nil
 }
 
 // ListByPlayer récupère les captures d'un joueur
-func (s *SQLStore) ListByPlayer(playerID string) ([]core.Word, error) {
+func (s *SQLStore) ListByPlayer(ctx context.Context, playerID string) ([]core.Word, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
 	query := `
 		SELECT w.id, w.text, w.rarity, w.points 
 		FROM captures c 
@@ -270,9 +465,9 @@ func (s *SQLStore) ListByPlayer(playerID string) ([]core.Word, error) {
 		WHERE c.player_id = $1 
 		ORDER BY c.captured_at DESC`
 
-	rows, err := s.db.Query(query, playerID)
+	rows, err := s.db.QueryContext(ctx, query, playerID)
 	if err != nil {
-		return nil, fmt.Errorf("erreur récupération captures: %w", err)
+		return nil, wrapErr(err, "erreur récupération captures")
 	}
 	defer rows.Close()
 
@@ -281,11 +476,370 @@ func (s *SQLStore) ListByPlayer(playerID string) ([]core.Word, error) {
 		var word core.Word
 		var rarityStr string
 		if err := rows.Scan(&word.ID, &word.Text, &rarityStr, &word.Points); err != nil {
-			return nil, err
+			return nil, wrapErr(err, "erreur lecture capture")
 		}
 		word.Rarity = core.Rarity(rarityStr)
 		words = append(words, word)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture captures")
+	}
+
+	return words, nil
+}
+
+// === WEBHOOK REPOSITORY ===
+
+// CreateWebhook enregistre un abonnement webhook
+func (s *SQLStore) CreateWebhook(ctx context.Context, sub *webhook.Subscription) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	if sub.ID == "" {
+		sub.ID = uuid.New().String()
+	}
+	if sub.CreatedAt.IsZero() {
+		sub.CreatedAt = time.Now().UTC()
+	}
+
+	query := `INSERT INTO webhooks (id, url, secret, events, active, created_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := s.db.ExecContext(ctx, query, sub.ID, sub.URL, sub.Secret, strings.Join(sub.Events, ","), sub.Active, sub.CreatedAt)
+	if err != nil {
+		return wrapErr(err, "erreur création webhook")
+	}
+	return nil
+}
+
+// GetWebhook récupère un abonnement par ID
+func (s *SQLStore) GetWebhook(ctx context.Context, id string) (*webhook.Subscription, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	query := `SELECT id, url, secret, events, active, created_at FROM webhooks WHERE id = $1`
+
+	sub, err := scanWebhook(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, notFound("webhook non trouvé: %s", id)
+		}
+		return nil, wrapErr(err, "erreur récupération webhook")
+	}
+	return sub, nil
+}
+
+// ListWebhooks récupère tous les abonnements
+func (s *SQLStore) ListWebhooks(ctx context.Context) ([]webhook.Subscription, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	query := `SELECT id, url, secret, events, active, created_at FROM webhooks ORDER BY created_at`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, wrapErr(err, "erreur récupération webhooks")
+	}
+	defer rows.Close()
+
+	var subs []webhook.Subscription
+	for rows.Next() {
+		sub, err := scanWebhook(rows)
+		if err != nil {
+			return nil, wrapErr(err, "erreur lecture webhook")
+		}
+		subs = append(subs, *sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture webhooks")
+	}
+	return subs, nil
+}
+
+// DeleteWebhook supprime un abonnement et son journal de livraisons
+func (s *SQLStore) DeleteWebhook(ctx context.Context, id string) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	result, err := s.db.ExecContext(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return wrapErr(err, "erreur suppression webhook")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return notFound("webhook non trouvé: %s", id)
+	}
+	return nil
+}
+
+// SaveDelivery insère ou met à jour une livraison dans le journal
+func (s *SQLStore) SaveDelivery(ctx context.Context, d *webhook.Delivery) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO webhook_deliveries
+			(id, webhook_id, event, payload, status, attempts, status_code, last_error, created_at, updated_at, delivered_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			attempts = EXCLUDED.attempts,
+			status_code = EXCLUDED.status_code,
+			last_error = EXCLUDED.last_error,
+			updated_at = EXCLUDED.updated_at,
+			delivered_at = EXCLUDED.delivered_at`
+
+	_, err := s.db.ExecContext(ctx, query, d.ID, d.WebhookID, d.Event, d.Payload, d.Status, d.Attempts,
+		d.StatusCode, d.LastError, d.CreatedAt, d.UpdatedAt, d.DeliveredAt)
+	if err != nil {
+		return wrapErr(err, "erreur enregistrement livraison")
+	}
+	return nil
+}
+
+// ListDeliveries récupère les dernières livraisons d'un abonnement, filtrées par statut si fourni
+func (s *SQLStore) ListDeliveries(ctx context.Context, webhookID, status string, limit int) ([]webhook.Delivery, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, webhook_id, event, payload, status, attempts, status_code, last_error, created_at, updated_at, delivered_at
+		FROM webhook_deliveries
+		WHERE webhook_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC
+		LIMIT NULLIF($3, 0)`, webhookID, status, max(limit, 0))
+	if err != nil {
+		return nil, wrapErr(err, "erreur récupération livraisons")
+	}
+	defer rows.Close()
 
+	var deliveries []webhook.Delivery
+	for rows.Next() {
+		var d webhook.Delivery
+		var deliveredAt sql.NullTime
+		if err := rows.Scan(&d.ID, &d.WebhookID, &d.Event, &d.Payload, &d.Status, &d.Attempts,
+			&d.StatusCode, &d.LastError, &d.CreatedAt, &d.UpdatedAt, &deliveredAt); err != nil {
+			return nil, wrapErr(err, "erreur lecture livraison")
+		}
+		if deliveredAt.Valid {
+			d.DeliveredAt = &deliveredAt.Time
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture livraisons")
+	}
+	return deliveries, nil
+}
+
+// rowScanner est implémenté par *sql.Row et *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanWebhook lit un abonnement depuis une ligne de résultat
+func scanWebhook(row rowScanner) (*webhook.Subscription, error) {
+	var sub webhook.Subscription
+	var events string
+	if err := row.Scan(&sub.ID, &sub.URL, &sub.Secret, &events, &sub.Active, &sub.CreatedAt); err != nil {
+		return nil, err
+	}
+	if events != "" {
+		sub.Events = strings.Split(events, ",")
+	}
+	return &sub, nil
+}
+
+// === TOKEN REPOSITORY ===
+
+// SaveToken enregistre l'empreinte d'un jeton de joueur
+func (s *SQLStore) SaveToken(ctx context.Context, t *auth.Token) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	query := `INSERT INTO player_tokens (id, player_id, token_hash, created_at) VALUES ($1, $2, $3, $4)`
+	_, err := s.db.ExecContext(ctx, query, t.ID, t.PlayerID, t.Hash, t.CreatedAt)
+	if err != nil {
+		return wrapErr(err, "erreur création jeton")
+	}
+	return nil
+}
+
+// GetToken récupère un jeton par ID
+func (s *SQLStore) GetToken(ctx context.Context, id string) (*auth.Token, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	token := &auth.Token{}
+	var revokedAt sql.NullTime
+	query := `SELECT id, player_id, token_hash, created_at, revoked_at FROM player_tokens WHERE id = $1`
+
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&token.ID, &token.PlayerID, &token.Hash, &token.CreatedAt, &revokedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, tokenNotFound(id)
+		}
+		return nil, wrapErr(err, "erreur récupération jeton")
+	}
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+
+	return token, nil
+}
+
+// RevokeToken révoque un jeton (sans effet s'il l'est déjà)
+func (s *SQLStore) RevokeToken(ctx context.Context, id string, at time.Time) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	query := `UPDATE player_tokens SET revoked_at = COALESCE(revoked_at, $1) WHERE id = $2`
+	result, err := s.db.ExecContext(ctx, query, at, id)
+	if err != nil {
+		return wrapErr(err, "erreur révocation jeton")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return notFound("jeton non trouvé: %s", id)
+	}
+	return nil
+}
+
+// RevokePlayerTokens révoque tous les jetons actifs d'un joueur
+func (s *SQLStore) RevokePlayerTokens(ctx context.Context, playerID string, at time.Time) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	query := `UPDATE player_tokens SET revoked_at = $1 WHERE player_id = $2 AND revoked_at IS NULL`
+	if _, err := s.db.ExecContext(ctx, query, at, playerID); err != nil {
+		return wrapErr(err, "erreur révocation jetons")
+	}
+	return nil
+}
+
+// === SAUVEGARDE ===
+
+// ListWords retourne le catalogue trié par ID
+func (s *SQLStore) ListWords(ctx context.Context) ([]core.Word, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `SELECT id, text, rarity, points FROM words ORDER BY id`)
+	if err != nil {
+		return nil, wrapErr(err, "erreur récupération mots")
+	}
+	defer rows.Close()
+
+	var words []core.Word
+	for rows.Next() {
+		var word core.Word
+		var rarityStr string
+		if err := rows.Scan(&word.ID, &word.Text, &rarityStr, &word.Points); err != nil {
+			return nil, wrapErr(err, "erreur lecture mot")
+		}
+		word.Rarity = core.Rarity(rarityStr)
+		words = append(words, word)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture catalogue")
+	}
 	return words, nil
 }
+
+// ListCaptures retourne les captures datées d'un joueur, dans l'ordre chronologique
+func (s *SQLStore) ListCaptures(ctx context.Context, playerID string) ([]Capture, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	query := `SELECT word_id, captured_at FROM captures WHERE player_id = $1 ORDER BY captured_at, id`
+	rows, err := s.db.QueryContext(ctx, query, playerID)
+	if err != nil {
+		return nil, wrapErr(err, "erreur récupération captures")
+	}
+	defer rows.Close()
+
+	var captures []Capture
+	for rows.Next() {
+		var capture Capture
+		if err := rows.Scan(&capture.WordID, &capture.CapturedAt); err != nil {
+			return nil, wrapErr(err, "erreur lecture capture")
+		}
+		captures = append(captures, capture)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture captures")
+	}
+	return captures, nil
+}
+
+// ListTokens retourne les jetons d'un joueur par date de création
+func (s *SQLStore) ListTokens(ctx context.Context, playerID string) ([]auth.Token, error) {
+	ctx, cancel := s.timeouts.read(ctx)
+	defer cancel()
+
+	query := `SELECT id, player_id, token_hash, created_at, revoked_at FROM player_tokens WHERE player_id = $1 ORDER BY created_at`
+	rows, err := s.db.QueryContext(ctx, query, playerID)
+	if err != nil {
+		return nil, wrapErr(err, "erreur récupération jetons")
+	}
+	defer rows.Close()
+
+	var tokens []auth.Token
+	for rows.Next() {
+		var token auth.Token
+		var revokedAt sql.NullTime
+		if err := rows.Scan(&token.ID, &token.PlayerID, &token.Hash, &token.CreatedAt, &revokedAt); err != nil {
+			return nil, wrapErr(err, "erreur lecture jeton")
+		}
+		if revokedAt.Valid {
+			token.RevokedAt = &revokedAt.Time
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapErr(err, "erreur lecture jetons")
+	}
+	return tokens, nil
+}
+
+// RestorePlayer insère un joueur tel quel ; l'ID est conservé si c'est un UUID
+func (s *SQLStore) RestorePlayer(ctx context.Context, p core.Player) (string, error) {
+	if err := validateName(p.Name); err != nil {
+		return "", err
+	}
+	if err := validateXP(p.XP, p.Level); err != nil {
+		return "", err
+	}
+	if err := ValidateTeam(p.Team); err != nil {
+		return "", err
+	}
+
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	id := restoredUUID(p.ID)
+	query := `INSERT INTO players (id, name, xp, level, team) VALUES ($1, $2, $3, $4, $5)`
+	if _, err := s.db.ExecContext(ctx, query, id, p.Name, p.XP, p.Level, p.Team); err != nil {
+		return "", wrapErr(err, "erreur restauration joueur")
+	}
+	return id, nil
+}
+
+// RestoreCapture insère une capture datée sans créditer d'XP
+func (s *SQLStore) RestoreCapture(ctx context.Context, playerID string, capture Capture) error {
+	ctx, cancel := s.timeouts.write(ctx)
+	defer cancel()
+
+	query := `INSERT INTO captures (id, player_id, word_id, captured_at) VALUES ($1, $2, $3, $4)`
+	if _, err := s.db.ExecContext(ctx, query, uuid.New().String(), playerID, capture.WordID, capture.CapturedAt); err != nil {
+		return wrapErr(err, "erreur restauration capture")
+	}
+	return nil
+}
+
+// restoredUUID conserve id s'il s'agit d'un UUID (colonne players.id),
+// sinon en génère un nouveau
+func restoredUUID(id string) string {
+	if _, err := uuid.Parse(id); err == nil {
+		return id
+	}
+	return uuid.New().String()
+}
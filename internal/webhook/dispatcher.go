@@ -0,0 +1,281 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/google/uuid"
+)
+
+// Options réglages des livraisons
+type Options struct {
+	MaxAttempts    int           // tentatives avant dead-letter
+	InitialBackoff time.Duration // délai avant la 2e tentative, doublé ensuite
+	MaxBackoff     time.Duration
+	Timeout        time.Duration // délai maximal d'une requête
+	Workers        int
+	QueueSize      int
+}
+
+// DefaultOptions retourne les réglages par défaut des livraisons
+func DefaultOptions() Options {
+	return Options{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		Timeout:        10 * time.Second,
+		Workers:        4,
+		QueueSize:      256,
+	}
+}
+
+// Dispatcher envoie les événements aux abonnements enregistrés, avec
+// nouvelles tentatives et backoff exponentiel. Un worker ne fait qu'une
+// tentative à la fois : les suivantes sont programmées par un timer, hors des
+// workers, pour qu'un receveur en panne ne bloque pas les autres.
+type Dispatcher struct {
+	store  Store
+	opts   Options
+	client *http.Client
+	queue  chan *Delivery
+}
+
+// NewDispatcher crée un dispatcher ; les workers démarrent avec Start
+func NewDispatcher(store Store, opts Options) *Dispatcher {
+	defaults := DefaultOptions()
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaults.MaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaults.InitialBackoff
+	}
+	if opts.MaxBackoff < opts.InitialBackoff {
+		opts.MaxBackoff = opts.InitialBackoff
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaults.Timeout
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = defaults.Workers
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = defaults.QueueSize
+	}
+
+	return &Dispatcher{
+		store:  store,
+		opts:   opts,
+		client: &http.Client{Timeout: opts.Timeout},
+		queue:  make(chan *Delivery, opts.QueueSize),
+	}
+}
+
+// Start démarre les workers de livraison jusqu'à l'annulation du contexte,
+// et reprend les livraisons restées en attente dans le journal
+func (d *Dispatcher) Start(ctx context.Context) {
+	for i := 0; i < d.opts.Workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case delivery := <-d.queue:
+					d.deliver(ctx, delivery)
+				}
+			}
+		}()
+	}
+
+	if err := d.resume(ctx); err != nil {
+		log.Printf("[webhook] Erreur reprise des livraisons en attente: %v", err)
+	}
+}
+
+// resume reprogramme les livraisons en attente (arrêt pendant un backoff),
+// chacune à l'échéance de sa prochaine tentative
+func (d *Dispatcher) resume(ctx context.Context) error {
+	subs, err := d.store.ListWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lecture webhooks: %w", err)
+	}
+
+	resumed := 0
+	for _, sub := range subs {
+		pending, err := d.store.ListDeliveries(ctx, sub.ID, StatusPending, 0)
+		if err != nil {
+			return fmt.Errorf("erreur lecture livraisons de %s: %w", sub.ID, err)
+		}
+		// Les plus anciennes d'abord
+		for i := len(pending) - 1; i >= 0; i-- {
+			delivery := pending[i]
+			due := delivery.UpdatedAt
+			if delivery.Attempts > 0 {
+				due = due.Add(d.backoff(delivery.Attempts))
+			}
+			d.schedule(ctx, &delivery, time.Until(due))
+			resumed++
+		}
+	}
+	if resumed > 0 {
+		log.Printf("[webhook] %d livraison(s) en attente reprise(s)", resumed)
+	}
+	return nil
+}
+
+// schedule remet la livraison en file après delay, sans occuper de worker ;
+// abandonnée (toujours en attente dans le journal) si ctx est annulé
+func (d *Dispatcher) schedule(ctx context.Context, delivery *Delivery, delay time.Duration) {
+	time.AfterFunc(max(delay, 0), func() {
+		select {
+		case d.queue <- delivery:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// backoff délai avant la tentative suivant la n-ième : InitialBackoff doublé
+// à chaque échec, plafonné à MaxBackoff
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	backoff := d.opts.InitialBackoff
+	for i := 1; i < attempts && backoff < d.opts.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, d.opts.MaxBackoff)
+}
+
+// Handle implémente core.EventHandler : les événements notables sont mis en
+// file pour chaque abonnement intéressé
+func (d *Dispatcher) Handle(e core.DomainEvent) {
+	event, data, ok := FromDomainEvent(e)
+	if !ok {
+		return
+	}
+	if err := d.Dispatch(context.Background(), event, data); err != nil {
+		log.Printf("[webhook] Erreur diffusion %s: %v", event, err)
+	}
+}
+
+// Dispatch enregistre une livraison par abonnement intéressé puis la met en file
+func (d *Dispatcher) Dispatch(ctx context.Context, event string, data interface{}) error {
+	subs, err := d.store.ListWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("erreur lecture webhooks: %w", err)
+	}
+
+	now := time.Now().UTC()
+	body, err := json.Marshal(Payload{ID: uuid.New().String(), Event: event, Time: now, Data: data})
+	if err != nil {
+		return fmt.Errorf("erreur encodage payload: %w", err)
+	}
+
+	for _, sub := range subs {
+		if !sub.Wants(event) {
+			continue
+		}
+
+		delivery := &Delivery{
+			ID:        uuid.New().String(),
+			WebhookID: sub.ID,
+			Event:     event,
+			Payload:   string(body),
+			Status:    StatusPending,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := d.store.SaveDelivery(ctx, delivery); err != nil {
+			return fmt.Errorf("erreur enregistrement livraison: %w", err)
+		}
+
+		select {
+		case d.queue <- delivery:
+		default:
+			// File saturée : la livraison part directement en dead-letter
+			d.finish(delivery, StatusDead, "file de livraison pleine")
+		}
+	}
+
+	return nil
+}
+
+// deliver fait une tentative de livraison ; en cas d'échec, la suivante est
+// programmée après le backoff, jusqu'à l'épuisement des tentatives
+func (d *Dispatcher) deliver(ctx context.Context, delivery *Delivery) {
+	sub, err := d.store.GetWebhook(ctx, delivery.WebhookID)
+	if err != nil {
+		d.finish(delivery, StatusDead, fmt.Sprintf("webhook introuvable: %v", err))
+		return
+	}
+
+	delivery.Attempts++
+	statusCode, err := d.post(ctx, sub, delivery)
+	delivery.StatusCode = statusCode
+	if err == nil {
+		d.finish(delivery, StatusDelivered, "")
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.opts.MaxAttempts {
+		log.Printf("[webhook] Livraison %s abandonnée après %d tentatives: %s",
+			delivery.ID, delivery.Attempts, delivery.LastError)
+		d.finish(delivery, StatusDead, delivery.LastError)
+		return
+	}
+
+	// Arrêt du serveur pendant le backoff : la livraison reste en attente
+	// dans le journal et reprend au prochain Start
+	d.save(delivery)
+	d.schedule(ctx, delivery, d.backoff(delivery.Attempts))
+}
+
+// post envoie la requête signée et retourne le code HTTP obtenu
+func (d *Dispatcher) post(ctx context.Context, sub *Subscription, delivery *Delivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("requête invalide: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "WordMon-Webhook/1.0")
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, time.Now(), body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("réponse HTTP %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// finish enregistre le statut final d'une livraison
+func (d *Dispatcher) finish(delivery *Delivery, status, lastError string) {
+	delivery.Status = status
+	delivery.LastError = lastError
+	if status == StatusDelivered {
+		now := time.Now().UTC()
+		delivery.DeliveredAt = &now
+	}
+	d.save(delivery)
+}
+
+// save met à jour la livraison dans le journal, même pendant l'arrêt du serveur
+func (d *Dispatcher) save(delivery *Delivery) {
+	delivery.UpdatedAt = time.Now().UTC()
+	if err := d.store.SaveDelivery(context.Background(), delivery); err != nil {
+		log.Printf("[webhook] Erreur journal livraison %s: %v", delivery.ID, err)
+	}
+}
@@ -0,0 +1,219 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+	"github.com/SamG1008/wordmon-go/internal/store"
+	"github.com/SamG1008/wordmon-go/internal/webhook"
+)
+
+// testOptions réglages rapides pour les tests
+func testOptions(maxAttempts int) webhook.Options {
+	return webhook.Options{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: 5 * time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+		Timeout:        time.Second,
+		Workers:        1,
+	}
+}
+
+// waitForStatus attend qu'une livraison de l'abonnement atteigne le statut voulu
+func waitForStatus(t *testing.T, s webhook.Store, webhookID, status string) webhook.Delivery {
+	t.Helper()
+	ctx := t.Context()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		deliveries, err := s.ListDeliveries(ctx, webhookID, status, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(deliveries) == 1 {
+			return deliveries[0]
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("aucune livraison %s pour %s", status, webhookID)
+	return webhook.Delivery{}
+}
+
+func TestDispatcherDeliversSignedPayload(t *testing.T) {
+	ctx := t.Context()
+	const secret = "s3cret"
+	received := make(chan error, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(webhook.HeaderEvent) != webhook.EventLegendaryCapture {
+			t.Errorf("événement inattendu: %s", r.Header.Get(webhook.HeaderEvent))
+		}
+		received <- webhook.Verify(secret, r.Header.Get(webhook.HeaderSignature), body, time.Minute)
+	}))
+	defer receiver.Close()
+
+	memStore := store.NewMemoryStore()
+	sub := &webhook.Subscription{URL: receiver.URL, Secret: secret, Events: []string{webhook.EventLegendaryCapture}, Active: true}
+	if err := memStore.CreateWebhook(ctx, sub); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dispatcher := webhook.NewDispatcher(memStore, testOptions(3))
+	dispatcher.Start(ctx)
+
+	// Une capture commune n'est pas diffusée, une capture légendaire l'est
+	player := core.Player{ID: "p1", Name: "Alice", XP: 10, Level: 1}
+	dispatcher.Handle(core.CaptureEvents(player, core.Word{ID: "c_1", Text: "chat", Rarity: core.Common, Points: 5}, 1)[0])
+	dispatcher.Handle(core.CaptureEvents(player, core.Word{ID: "l_1", Text: "dragon", Rarity: core.Legendary, Points: 100}, 1)[0])
+
+	select {
+	case err := <-received:
+		if err != nil {
+			t.Errorf("signature refusée: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("aucune livraison reçue")
+	}
+
+	delivery := waitForStatus(t, memStore, sub.ID, webhook.StatusDelivered)
+	if delivery.Attempts != 1 || delivery.StatusCode != http.StatusOK || delivery.DeliveredAt == nil {
+		t.Errorf("livraison inattendue: %+v", delivery)
+	}
+	if all, _ := memStore.ListDeliveries(ctx, sub.ID, "", 0); len(all) != 1 {
+		t.Errorf("attendu 1 livraison, obtenu %d", len(all))
+	}
+}
+
+func TestDispatcherRetriesWithBackoff(t *testing.T) {
+	ctx := t.Context()
+	var calls atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer receiver.Close()
+
+	memStore := store.NewMemoryStore()
+	sub := &webhook.Subscription{URL: receiver.URL, Secret: "x", Events: webhook.Events, Active: true}
+	memStore.CreateWebhook(ctx, sub)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dispatcher := webhook.NewDispatcher(memStore, testOptions(5))
+	dispatcher.Start(ctx)
+
+	dispatcher.Handle(core.LevelUp{PlayerID: "p1", PlayerName: "Alice", PreviousLevel: 1, Level: 2})
+
+	delivery := waitForStatus(t, memStore, sub.ID, webhook.StatusDelivered)
+	if delivery.Attempts != 3 {
+		t.Errorf("attendu 3 tentatives, obtenu %d", delivery.Attempts)
+	}
+}
+
+func TestDispatcherDeadLettersAfterMaxAttempts(t *testing.T) {
+	ctx := t.Context()
+	var calls atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	memStore := store.NewMemoryStore()
+	sub := &webhook.Subscription{URL: receiver.URL, Secret: "x", Events: []string{webhook.EventPlayerCreated}, Active: true}
+	memStore.CreateWebhook(ctx, sub)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dispatcher := webhook.NewDispatcher(memStore, testOptions(2))
+	dispatcher.Start(ctx)
+
+	dispatcher.Handle(core.PlayerCreated{PlayerID: "p1", Name: "Alice"})
+
+	delivery := waitForStatus(t, memStore, sub.ID, webhook.StatusDead)
+	if delivery.Attempts != 2 || delivery.StatusCode != http.StatusInternalServerError || delivery.LastError == "" {
+		t.Errorf("dead-letter inattendu: %+v", delivery)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("attendu 2 appels, obtenu %d", calls.Load())
+	}
+}
+
+func TestDispatcherRetryDoesNotBlockWorkers(t *testing.T) {
+	ctx := t.Context()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer healthy.Close()
+
+	memStore := store.NewMemoryStore()
+	down := &webhook.Subscription{URL: failing.URL, Secret: "x", Events: webhook.Events, Active: true}
+	up := &webhook.Subscription{URL: healthy.URL, Secret: "x", Events: webhook.Events, Active: true}
+	memStore.CreateWebhook(ctx, down)
+	memStore.CreateWebhook(ctx, up)
+
+	// Un seul worker et un backoff plus long que l'attente du test
+	opts := testOptions(5)
+	opts.InitialBackoff, opts.MaxBackoff = time.Minute, time.Minute
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dispatcher := webhook.NewDispatcher(memStore, opts)
+	dispatcher.Start(ctx)
+
+	dispatcher.Handle(core.PlayerCreated{PlayerID: "p1", Name: "Alice"})
+
+	waitForStatus(t, memStore, up.ID, webhook.StatusDelivered)
+	if pending := waitForStatus(t, memStore, down.ID, webhook.StatusPending); pending.Attempts != 1 {
+		t.Errorf("1 tentative puis attente attendue: %+v", pending)
+	}
+}
+
+func TestDispatcherResumesPendingDeliveries(t *testing.T) {
+	ctx := t.Context()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer receiver.Close()
+
+	memStore := store.NewMemoryStore()
+	sub := &webhook.Subscription{URL: receiver.URL, Secret: "x", Events: webhook.Events, Active: true}
+	memStore.CreateWebhook(ctx, sub)
+
+	// Livraison interrompue par un arrêt pendant son backoff
+	now := time.Now().UTC()
+	memStore.SaveDelivery(ctx, &webhook.Delivery{
+		ID: "d1", WebhookID: sub.ID, Event: webhook.EventPlayerCreated, Payload: "{}",
+		Status: webhook.StatusPending, Attempts: 1, LastError: "réponse HTTP 503",
+		CreatedAt: now, UpdatedAt: now,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	webhook.NewDispatcher(memStore, testOptions(3)).Start(ctx)
+
+	if delivery := waitForStatus(t, memStore, sub.ID, webhook.StatusDelivered); delivery.ID != "d1" || delivery.Attempts != 2 {
+		t.Errorf("livraison reprise attendue: %+v", delivery)
+	}
+}
+
+func TestVerifyRejectsTamperedBody(t *testing.T) {
+	header := webhook.Sign("secret", time.Now(), []byte(`{"a":1}`))
+
+	if err := webhook.Verify("secret", header, []byte(`{"a":1}`), time.Minute); err != nil {
+		t.Errorf("signature valide refusée: %v", err)
+	}
+	if err := webhook.Verify("secret", header, []byte(`{"a":2}`), time.Minute); err == nil {
+		t.Error("corps modifié accepté")
+	}
+	if err := webhook.Verify("autre", header, []byte(`{"a":1}`), time.Minute); err == nil {
+		t.Error("mauvais secret accepté")
+	}
+}
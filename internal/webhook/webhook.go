@@ -0,0 +1,232 @@
+// Package webhook diffuse les événements notables du jeu vers des URL externes
+// (bot Discord, tableaux de bord) par des POST JSON signés HMAC.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SamG1008/wordmon-go/internal/core"
+)
+
+// Événements diffusés par webhook
+const (
+	EventLegendaryCapture = "legendary_capture"
+	EventLevelUp          = "level_up"
+	EventPlayerCreated    = "player_created"
+)
+
+// Events liste les événements auxquels un webhook peut s'abonner
+var Events = []string{EventLegendaryCapture, EventLevelUp, EventPlayerCreated}
+
+// Statuts d'une livraison
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusDead      = "dead" // abandonnée après épuisement des tentatives
+)
+
+// En-têtes HTTP des livraisons
+const (
+	HeaderEvent     = "X-WordMon-Event"
+	HeaderDelivery  = "X-WordMon-Delivery"
+	HeaderSignature = "X-WordMon-Signature"
+)
+
+// Subscription abonnement d'une URL à une liste d'événements
+type Subscription struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Wants indique si l'abonnement reçoit l'événement
+func (s Subscription) Wants(event string) bool {
+	if !s.Active {
+		return false
+	}
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Delivery trace l'envoi d'un événement à un abonnement
+type Delivery struct {
+	ID          string     `json:"id"`
+	WebhookID   string     `json:"webhookId"`
+	Event       string     `json:"event"`
+	Payload     string     `json:"payload"`
+	Status      string     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	StatusCode  int        `json:"statusCode,omitempty"`
+	LastError   string     `json:"lastError,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+}
+
+// Store persistance des abonnements et du journal des livraisons
+type Store interface {
+	CreateWebhook(ctx context.Context, sub *Subscription) error
+	GetWebhook(ctx context.Context, id string) (*Subscription, error)
+	ListWebhooks(ctx context.Context) ([]Subscription, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	SaveDelivery(ctx context.Context, d *Delivery) error
+	ListDeliveries(ctx context.Context, webhookID, status string, limit int) ([]Delivery, error)
+}
+
+// Payload corps JSON envoyé au receveur
+type Payload struct {
+	ID    string      `json:"id"`
+	Event string      `json:"event"`
+	Time  time.Time   `json:"time"`
+	Data  interface{} `json:"data"`
+}
+
+// PlayerData contenu d'un événement player_created
+type PlayerData struct {
+	PlayerID string `json:"playerId"`
+	Name     string `json:"name"`
+}
+
+// CaptureData contenu d'un événement legendary_capture
+type CaptureData struct {
+	PlayerID   string `json:"playerId"`
+	PlayerName string `json:"playerName"`
+	WordID     string `json:"wordId"`
+	Word       string `json:"word"`
+	Rarity     string `json:"rarity"`
+	XPGained   int    `json:"xpGained"`
+}
+
+// LevelUpData contenu d'un événement level_up
+type LevelUpData struct {
+	PlayerID      string `json:"playerId"`
+	PlayerName    string `json:"playerName"`
+	PreviousLevel int    `json:"previousLevel"`
+	Level         int    `json:"level"`
+}
+
+// FromDomainEvent traduit un événement du domaine en événement webhook ;
+// ok est faux si l'événement n'est pas diffusé
+func FromDomainEvent(e core.DomainEvent) (event string, data interface{}, ok bool) {
+	switch ev := e.(type) {
+	case core.PlayerCreated:
+		return EventPlayerCreated, PlayerData{PlayerID: ev.PlayerID, Name: ev.Name}, true
+	case core.WordCaptured:
+		if ev.Word.Rarity != core.Legendary {
+			return "", nil, false
+		}
+		return EventLegendaryCapture, CaptureData{
+			PlayerID:   ev.PlayerID,
+			PlayerName: ev.PlayerName,
+			WordID:     ev.Word.ID,
+			Word:       ev.Word.Text,
+			Rarity:     string(ev.Word.Rarity),
+			XPGained:   ev.XPGained,
+		}, true
+	case core.LevelUp:
+		return EventLevelUp, LevelUpData{
+			PlayerID:      ev.PlayerID,
+			PlayerName:    ev.PlayerName,
+			PreviousLevel: ev.PreviousLevel,
+			Level:         ev.Level,
+		}, true
+	}
+	return "", nil, false
+}
+
+// ValidateEvents vérifie qu'une liste d'événements est non vide et connue
+func ValidateEvents(events []string) error {
+	if len(events) == 0 {
+		return errors.New("au moins un événement requis")
+	}
+	for _, e := range events {
+		known := false
+		for _, valid := range Events {
+			if e == valid {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("événement inconnu: %s (valides: %s)", e, strings.Join(Events, ", "))
+		}
+	}
+	return nil
+}
+
+// NewSecret génère un secret de signature aléatoire
+func NewSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("erreur génération secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Sign calcule l'en-tête de signature "t=<unix>,v1=<hex>" où v1 est le
+// HMAC-SHA256 de "<unix>.<body>" avec le secret de l'abonnement
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + ts + ",v1=" + computeMAC(secret, ts, body)
+}
+
+// Verify vérifie l'en-tête de signature d'une livraison reçue.
+// tolerance borne l'âge accepté de l'horodatage (0 pour ne pas le vérifier).
+func Verify(secret, header string, body []byte, tolerance time.Duration) error {
+	var ts, mac string
+	for _, part := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			mac = value
+		}
+	}
+	if ts == "" || mac == "" {
+		return errors.New("signature mal formée")
+	}
+
+	if tolerance > 0 {
+		unix, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return fmt.Errorf("horodatage invalide: %w", err)
+		}
+		if age := time.Since(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+			return errors.New("signature expirée")
+		}
+	}
+
+	if !hmac.Equal([]byte(mac), []byte(computeMAC(secret, ts, body))) {
+		return errors.New("signature invalide")
+	}
+	return nil
+}
+
+// computeMAC calcule le HMAC-SHA256 hexadécimal de "<ts>.<body>"
+func computeMAC(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}